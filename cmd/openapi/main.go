@@ -0,0 +1,49 @@
+// Command openapi writes the HTTP API's OpenAPI spec, the same document the server
+// serves at /openapi.json. The Go client in pkg/client is generated from its output.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/stocky/assignment/internal/handlers"
+)
+
+func main() {
+	out := flag.String("o", "-", "file to write the spec to, - for stdout")
+	flag.Parse()
+
+	if err := run(*out); err != nil {
+		fmt.Fprintf(os.Stderr, "openapi: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out string) error {
+	data, err := encodeSpec()
+	if err != nil {
+		return err
+	}
+
+	if out == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(out, data, 0o644)
+}
+
+// encodeSpec returns the spec as it is committed in api/openapi.json
+func encodeSpec() ([]byte, error) {
+	spec, err := handlers.APISpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build spec: %w", err)
+	}
+
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spec: %w", err)
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestCommittedSpecIsCurrent fails when api/openapi.json, and so the generated
+// client, no longer matches the spec the server builds
+func TestCommittedSpecIsCurrent(t *testing.T) {
+	want, err := encodeSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../api/openapi.json")
+	if err != nil {
+		t.Fatalf("failed to read the committed spec: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("api/openapi.json is out of date; run go generate ./pkg/client and commit the result")
+	}
+}
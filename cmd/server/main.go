@@ -1,19 +1,30 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"github.com/stocky/assignment/internal/config"
 	"github.com/stocky/assignment/internal/database"
+	"github.com/stocky/assignment/internal/grpcserver"
 	"github.com/stocky/assignment/internal/handlers"
-	"github.com/stocky/assignment/internal/middleware"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/ratelimit"
 	"github.com/stocky/assignment/internal/repository"
 	"github.com/stocky/assignment/internal/services"
+	"github.com/stocky/assignment/internal/tracing"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -35,36 +46,201 @@ func main() {
 	// Set Gin mode
 	gin.SetMode(cfg.Server.GinMode)
 
-	// Connect to database
-	db, err := database.Connect(cfg.Database.GetDSN(), log)
+	// Install the tracer provider before anything opens spans
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:      cfg.Tracing.Exporter,
+		ServiceName:   cfg.Tracing.ServiceName,
+		SamplePercent: cfg.Tracing.SamplePercent,
+		OTLPEndpoint:  cfg.Tracing.OTLPEndpoint,
+	}, log)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+
+	// Connect to database, waiting for it if it is still starting
+	pool := database.PoolFromConfig(cfg.Database)
+	db, err := database.Connect(context.Background(), cfg.Database.GetDSN(), pool, log)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
+	// Read-only endpoints use the replica when one is configured
+	readDB := db
+	var replicaDB *sql.DB
+	if cfg.Database.ReplicaDSN != "" {
+		replicaDB, err = database.Connect(context.Background(), cfg.Database.ReplicaDSN, pool, log)
+		if err != nil {
+			log.Fatalf("Failed to connect to read replica: %v", err)
+		}
+		defer replicaDB.Close()
+		readDB = replicaDB
+	}
+
+	// Expose connection pool stats to Prometheus
+	if err := metrics.RegisterDBStats(db, cfg.Database.DBName); err != nil {
+		log.Fatalf("Failed to register database metrics: %v", err)
+	}
+	if replicaDB != nil {
+		if err := metrics.RegisterDBStats(replicaDB, cfg.Database.DBName+"_replica"); err != nil {
+			log.Fatalf("Failed to register replica metrics: %v", err)
+		}
+	}
+
 	// Run migrations
-	if err := database.RunMigrations(db, "migrations", log); err != nil {
+	const migrationsPath = "migrations"
+	if err := database.RunMigrations(context.Background(), db, migrationsPath, log); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	expectedMigrationVersion, err := database.LatestMigrationVersion(migrationsPath)
+	if err != nil {
+		log.Fatalf("Failed to read migration version: %v", err)
+	}
+
 	// Initialize repositories
-	rewardRepo := repository.NewRewardRepository(db)
-	stockRepo := repository.NewStockRepository(db)
+	txManager := repository.NewTxManager(db)
+	rewardRepo := repository.NewRewardRepositoryWithReplica(db, readDB)
+	stockRepo := repository.NewCachedStockRepository(
+		repository.NewStockRepository(db),
+		repository.StockCacheConfig{
+			StockTTL: 5 * time.Minute,
+			PriceTTL: time.Minute,
+		},
+	)
 	ledgerRepo := repository.NewLedgerRepository(db)
+	healthRepo := repository.NewHealthRepositoryWithReplica(db, replicaDB)
+	feeRepo := repository.NewFeeScheduleRepository(db)
+	taxLotRepo := repository.NewTaxLotRepositoryWithReplica(db, readDB)
+	disposalRepo := repository.NewDisposalRepositoryWithReplica(db, readDB)
+	vestingRepo := repository.NewVestingRepositoryWithReplica(db, readDB)
+	webhookRepo := repository.NewWebhookRepository(db)
+	priceRetentionRepo := repository.NewPriceRetentionRepository(db)
+	reconciliationRepo := repository.NewReconciliationRepository(db)
+	budgetRepo := repository.NewBudgetRepository(db)
+	riskRepo := repository.NewRiskRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
 
 	// Initialize services
-	priceService := services.NewStockPriceService(stockRepo, log)
+	priceHub := services.NewPriceHub()
+	priceService := services.NewStockPriceService(stockRepo, priceHub, log)
+	feeService := services.NewFeeScheduleService(
+		txManager,
+		feeRepo,
+		auditRepo,
+		services.FeesConfig{
+			BrokerageFeeBP: cfg.Fees.BrokerageFeeBP,
+			STTFeeBP:       cfg.Fees.STTFeeBP,
+			GSTFeePercent:  cfg.Fees.GSTFeePercent,
+			ExchangeFeeBP:  cfg.Fees.ExchangeFeeBP,
+			SEBIFeeBP:      cfg.Fees.SEBIFeeBP,
+		},
+		log,
+	)
+	var riskPipeline *services.RiskPipeline
+	if cfg.Risk.Enabled {
+		riskPipeline = services.NewRiskPipeline(services.DefaultRiskChecks(services.RiskConfig{
+			VelocityWindow:      time.Duration(cfg.Risk.VelocityWindowMinutes) * time.Minute,
+			MaxUsersPerDevice:   cfg.Risk.MaxUsersPerDevice,
+			MaxUsersPerIP:       cfg.Risk.MaxUsersPerIP,
+			MaxRewardsPerUser:   cfg.Risk.MaxRewardsPerUser,
+			MaxUsersPerMetadata: cfg.Risk.MaxUsersPerMetadata,
+		})...)
+	}
 	rewardService := services.NewRewardService(
+		txManager,
 		rewardRepo,
 		stockRepo,
 		ledgerRepo,
+		taxLotRepo,
+		vestingRepo,
+		webhookRepo,
+		budgetRepo,
+		riskRepo,
+		auditRepo,
 		priceService,
-		services.FeesConfig{
-			BrokerageFeeBC: cfg.Fees.BrokerageFeeBC,
-			STTFeeBC:       cfg.Fees.STTFeeBC,
-			GSTFeeBC:       cfg.Fees.GSTFeeBC,
-			ExchangeFeeBC:  cfg.Fees.ExchangeFeeBC,
-			SEBIFeeBC:      cfg.Fees.SEBIFeeBC,
+		feeService,
+		services.RewardBudgetConfig{
+			MaxRewardINR:   float64(cfg.Budgets.MaxRewardINR),
+			UserDailyINR:   float64(cfg.Budgets.UserDailyINR),
+			UserMonthlyINR: float64(cfg.Budgets.UserMonthlyINR),
+			GlobalDailyINR: float64(cfg.Budgets.GlobalDailyINR),
+			ReasonDailyINR: cfg.Budgets.ReasonDailyINRFloat(),
+		},
+		riskPipeline,
+		log,
+	)
+	riskService := services.NewRiskService(txManager, riskRepo, auditRepo, log)
+	auditService := services.NewAuditService(auditRepo, log)
+
+	taxService := services.NewTaxService(taxLotRepo, log)
+	vestingService := services.NewVestingService(txManager, rewardRepo, vestingRepo, taxLotRepo, ledgerRepo, webhookRepo, auditRepo, log)
+	disposalService := services.NewDisposalService(
+		txManager,
+		rewardRepo,
+		taxLotRepo,
+		disposalRepo,
+		vestingRepo,
+		ledgerRepo,
+		priceService,
+		feeService,
+		auditRepo,
+		services.DisposalConfig{
+			SettlementPeriod: time.Duration(cfg.Service.SettlementDays) * 24 * time.Hour,
+		},
+		log,
+	)
+	healthService := services.NewHealthService(
+		healthRepo,
+		services.HealthConfig{
+			ExpectedMigrationVersion: expectedMigrationVersion,
+			MaxDBPingLatency:         time.Duration(cfg.Service.ReadinessDBLatencyMs) * time.Millisecond,
+			MaxPriceAge:              time.Duration(cfg.Service.PriceMaxAgeMinutes) * time.Minute,
+			CheckTimeout:             2 * time.Second,
+		},
+		log,
+	)
+
+	streamingService := services.NewStreamingService(
+		priceHub,
+		rewardService,
+		services.StreamConfig{
+			SubscriberBuffer:  64,
+			PortfolioDebounce: time.Second,
+		},
+		log,
+	)
+	webhookService := services.NewWebhookService(
+		webhookRepo,
+		services.WebhookConfig{
+			PollInterval:   time.Duration(cfg.Service.WebhookPollIntervalSeconds) * time.Second,
+			RequestTimeout: time.Duration(cfg.Service.WebhookTimeoutSeconds) * time.Second,
+			MaxAttempts:    cfg.Service.WebhookMaxAttempts,
+			BaseBackoff:    time.Duration(cfg.Service.WebhookBaseBackoffSeconds) * time.Second,
+			MaxBackoff:     time.Duration(cfg.Service.WebhookMaxBackoffSeconds) * time.Second,
+			BatchSize:      50,
+		},
+		log,
+	)
+
+	priceRetentionService := services.NewPriceRetentionService(
+		txManager,
+		priceRetentionRepo,
+		services.PriceRetentionConfig{
+			RetentionDays: cfg.Service.PriceRetentionDays,
+			Interval:      time.Duration(cfg.Service.PriceRetentionIntervalHours) * time.Hour,
+		},
+		log,
+	)
+
+	reconciliationService := services.NewReconciliationService(
+		txManager,
+		reconciliationRepo,
+		rewardRepo,
+		auditRepo,
+		services.ReconciliationConfig{
+			Interval:   time.Duration(cfg.Service.ReconcileIntervalMinutes) * time.Minute,
+			AutoRepair: cfg.Service.ReconcileAutoRepair,
 		},
 		log,
 	)
@@ -72,33 +248,148 @@ func main() {
 	// Start stock price updater
 	priceService.StartPriceUpdater(cfg.Service.PriceUpdateIntervalMinutes)
 
+	// Start webhook delivery worker
+	webhookService.StartDeliveryWorker()
+
+	// Start price partition maintenance and tick retention
+	priceRetentionService.Start()
+
+	// Start holdings reconciliation against the event log
+	reconciliationService.Start()
+
 	// Initialize handlers
-	rewardHandler := handlers.NewRewardHandler(rewardService, log)
+	routes := routeHandlers{
+		reward:   handlers.NewRewardHandler(rewardService, log),
+		health:   handlers.NewHealthHandler(healthService, log),
+		fee:      handlers.NewFeeScheduleHandler(feeService, log),
+		tax:      handlers.NewTaxHandler(taxService, log),
+		disposal: handlers.NewDisposalHandler(disposalService, log),
+		vesting:  handlers.NewVestingHandler(vestingService, log),
+		webhook:  handlers.NewWebhookHandler(webhookService, log),
+		stream:   handlers.NewStreamHandler(streamingService, log),
+		risk:     handlers.NewRiskHandler(riskService, log),
+		audit:    handlers.NewAuditHandler(auditService, log),
+	}
 
-	// Setup router
-	router := gin.New()
-	router.Use(middleware.RecoveryMiddleware(log))
-	router.Use(middleware.LoggingMiddleware(log))
-	router.Use(middleware.CORSMiddleware())
+	// OpenAPI spec, built from the handlers' request and response types
+	apiSpec, err := handlers.APISpec()
+	if err != nil {
+		log.Fatalf("Failed to build OpenAPI spec: %v", err)
+	}
 
-	// Health check
-	router.GET("/health", rewardHandler.HealthCheck)
+	// REST and gRPC calls take tokens from the same buckets
+	limiter := ratelimit.NewMemoryLimiter()
 
-	// API routes
-	api := router.Group("/api/v1")
-	{
-		api.POST("/reward", rewardHandler.CreateReward)
-		api.GET("/today-stocks/:userId", rewardHandler.GetTodayStocks)
-		api.GET("/historical-inr/:userId", rewardHandler.GetHistoricalINR)
-		api.GET("/stats/:userId", rewardHandler.GetStats)
-		api.GET("/portfolio/:userId", rewardHandler.GetPortfolio)
+	// Setup router; it refuses to start when a route is missing from the spec
+	router, err := newRouter(cfg, routes, limiter, apiSpec, log)
+	if err != nil {
+		log.Fatalf("Failed to set up routes: %v", err)
 	}
 
 	// Start server
 	addr := fmt.Sprintf(":%s", cfg.Server.Port)
-	log.Infof("Starting Stocky API server on %s", addr)
-	
-	if err := router.Run(addr); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: router,
+	}
+
+	// Shutdown waits for open connections; closing the hub ends every stream
+	srv.RegisterOnShutdown(priceHub.Close)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Infof("Starting Stocky API server on %s", addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	// The gRPC API for internal services shares the services above but has its own port
+	var grpcSrv *grpc.Server
+	var grpcErr chan error
+	if cfg.Server.GRPCPort != "" {
+		grpcAddr := fmt.Sprintf(":%s", cfg.Server.GRPCPort)
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			log.Fatalf("Failed to listen for gRPC on %s: %v", grpcAddr, err)
+		}
+		var grpcLimits *grpcserver.RateLimits
+		if cfg.RateLimit.Enabled {
+			grpcLimits = &grpcserver.RateLimits{
+				Limiter: limiter,
+				Write:   rateLimitPolicy("write", cfg.RateLimit.Write),
+				Read:    rateLimitPolicy("read", cfg.RateLimit.Read),
+			}
+		}
+		grpcSrv = grpcserver.NewServer(rewardService, time.Duration(cfg.Server.RequestTimeoutSeconds)*time.Second, grpcLimits, log)
+		grpcErr = make(chan error, 1)
+		go func() {
+			log.Infof("Starting Stocky gRPC server on %s", grpcAddr)
+			if err := grpcSrv.Serve(lis); err != nil {
+				grpcErr <- err
+			}
+			close(grpcErr)
+		}()
+	}
+
+	select {
+	case err := <-serverErr:
+		if err != nil {
+			log.Errorf("Server failed: %v", err)
+		}
+	case err := <-grpcErr:
+		if err != nil {
+			log.Errorf("gRPC server failed: %v", err)
+		}
+	case <-ctx.Done():
+		log.Info("Shutdown signal received, draining connections...")
+	}
+
+	// Stop accepting new requests and wait for in-flight ones to finish
+	shutdownCtx, cancel := context.WithTimeout(
+		context.Background(),
+		time.Duration(cfg.Server.ShutdownTimeoutSeconds)*time.Second,
+	)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Errorf("Server shutdown did not complete cleanly: %v", err)
+	}
+	if grpcSrv != nil {
+		stopGRPC(shutdownCtx, grpcSrv, log)
+	}
+
+	// Let in-flight background work finish before the database is closed
+	priceService.Stop()
+	webhookService.Stop()
+	priceRetentionService.Stop()
+	reconciliationService.Stop()
+
+	// Flush spans still buffered for export
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Errorf("Failed to flush traces: %v", err)
+	}
+
+	log.Info("Stocky API server stopped")
+}
+
+// stopGRPC waits for in-flight gRPC calls like http.Server.Shutdown does, cutting
+// them off once ctx expires
+func stopGRPC(ctx context.Context, srv *grpc.Server, log *logrus.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Errorf("gRPC server shutdown did not complete cleanly: %v", ctx.Err())
+		srv.Stop()
 	}
 }
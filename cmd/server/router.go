@@ -0,0 +1,129 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/config"
+	"github.com/stocky/assignment/internal/handlers"
+	"github.com/stocky/assignment/internal/middleware"
+	"github.com/stocky/assignment/internal/openapi"
+	"github.com/stocky/assignment/internal/ratelimit"
+)
+
+// routeHandlers are the HTTP handlers the router dispatches to
+type routeHandlers struct {
+	reward   *handlers.RewardHandler
+	health   *handlers.HealthHandler
+	fee      *handlers.FeeScheduleHandler
+	tax      *handlers.TaxHandler
+	disposal *handlers.DisposalHandler
+	vesting  *handlers.VestingHandler
+	webhook  *handlers.WebhookHandler
+	stream   *handlers.StreamHandler
+	risk     *handlers.RiskHandler
+	audit    *handlers.AuditHandler
+}
+
+// newRouter registers every HTTP route. It fails when the routes and apiSpec
+// disagree, since the Go client is generated from the spec.
+func newRouter(cfg *config.Config, h routeHandlers, limiter ratelimit.Limiter, apiSpec *openapi.Document, log *logrus.Logger) (*gin.Engine, error) {
+	router := gin.New()
+	router.Use(middleware.TracingMiddleware())
+	router.Use(middleware.RequestContextMiddleware(log))
+	router.Use(middleware.RecoveryMiddleware(log))
+	router.Use(middleware.LoggingMiddleware(log))
+	router.Use(middleware.MetricsMiddleware())
+	router.Use(middleware.CORSMiddleware())
+
+	// Health check
+	router.GET("/health", h.reward.HealthCheck)
+	router.GET("/livez", h.health.Liveness)
+	router.GET("/readyz", h.health.Readiness)
+
+	// Prometheus metrics
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// OpenAPI spec, built from the handlers' request and response types
+	router.GET("/openapi.json", handlers.OpenAPI(apiSpec))
+
+	// Rate limits per route group, keyed by API client, user and IP
+	rateLimit := func(group string, limits config.RateLimitGroup) gin.HandlerFunc {
+		if !cfg.RateLimit.Enabled {
+			return func(c *gin.Context) { c.Next() }
+		}
+		return middleware.RateLimitMiddleware(limiter, rateLimitPolicy(group, limits), log)
+	}
+	writeLimit := rateLimit("write", cfg.RateLimit.Write)
+	readLimit := rateLimit("read", cfg.RateLimit.Read)
+
+	// API routes
+	api := router.Group("/api/v1")
+	api.Use(middleware.TimeoutMiddleware(time.Duration(cfg.Server.RequestTimeoutSeconds) * time.Second))
+	{
+		api.POST("/reward", writeLimit, h.reward.CreateReward)
+		api.GET("/today-stocks/:userId", readLimit, h.reward.GetTodayStocks)
+		api.GET("/historical-inr/:userId", readLimit, h.reward.GetHistoricalINR)
+		api.GET("/stats/:userId", readLimit, h.reward.GetStats)
+		api.GET("/portfolio/:userId", readLimit, h.reward.GetPortfolio)
+
+		api.GET("/fee-schedules", readLimit, h.fee.ListFeeSchedules)
+		api.GET("/fee-schedules/effective", readLimit, h.fee.GetEffectiveFeeSchedule)
+
+		api.GET("/tax-report/:userId", readLimit, h.tax.GetTaxReport)
+
+		api.POST("/sell", writeLimit, h.disposal.Sell)
+		api.POST("/transfer-out", writeLimit, h.disposal.TransferOut)
+		api.GET("/disposals/:userId", readLimit, h.disposal.GetDisposals)
+
+		// Admin routes need the admin bearer token
+		admin := api.Group("/admin")
+		admin.Use(middleware.AdminAuthMiddleware(cfg.Server.AdminTokens, log))
+		{
+			admin.POST("/rewards", writeLimit, h.reward.CreateRewardWithOverride)
+			admin.POST("/rewards/:id/invalidate", writeLimit, h.vesting.InvalidateReward)
+			admin.GET("/budget-overrides", readLimit, h.reward.ListBudgetOverrides)
+			admin.GET("/reward-reviews", readLimit, h.reward.ListRewardReviews)
+			admin.POST("/reward-reviews/:id/approve", writeLimit, h.reward.ApproveRewardReview)
+			admin.POST("/reward-reviews/:id/reject", writeLimit, h.reward.RejectRewardReview)
+			admin.POST("/risk/blocklist", writeLimit, h.risk.AddBlocklistEntry)
+			admin.GET("/risk/blocklist", readLimit, h.risk.ListBlocklist)
+			admin.DELETE("/risk/blocklist/:id", writeLimit, h.risk.RemoveBlocklistEntry)
+			admin.GET("/audit-log", readLimit, h.audit.ListEntries)
+			admin.GET("/audit-log/verify", readLimit, h.audit.VerifyChain)
+
+			admin.POST("/fee-schedules", writeLimit, h.fee.CreateFeeSchedule)
+
+			admin.POST("/webhooks/subscriptions", writeLimit, h.webhook.CreateSubscription)
+			admin.GET("/webhooks/subscriptions", readLimit, h.webhook.ListSubscriptions)
+			admin.DELETE("/webhooks/subscriptions/:id", writeLimit, h.webhook.DeactivateSubscription)
+			admin.GET("/webhooks/deliveries", readLimit, h.webhook.ListDeliveries)
+			admin.POST("/webhooks/deliveries/:id/replay", writeLimit, h.webhook.ReplayDelivery)
+		}
+	}
+
+	// Streaming routes are long-lived, so they sit outside the request timeout
+	stream := router.Group("/api/v1/stream")
+	{
+		stream.GET("/:userId", readLimit, h.stream.StreamPortfolio)
+	}
+
+	// Every route must be described in the spec the client is generated from
+	if err := openapi.CheckRoutes(apiSpec, router.Routes()); err != nil {
+		return nil, err
+	}
+	return router, nil
+}
+
+// rateLimitPolicy turns a group's settings into the policy both APIs enforce
+func rateLimitPolicy(group string, limits config.RateLimitGroup) ratelimit.Policy {
+	burst := limits.Burst
+	return ratelimit.Policy{
+		Group:  group,
+		User:   ratelimit.Limit{PerMinute: limits.UserPerMinute, Burst: burst},
+		IP:     ratelimit.Limit{PerMinute: limits.IPPerMinute, Burst: burst},
+		Client: ratelimit.Limit{PerMinute: limits.ClientPerMinute, Burst: burst},
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/config"
+	"github.com/stocky/assignment/internal/handlers"
+	"github.com/stocky/assignment/internal/openapi"
+	"github.com/stocky/assignment/internal/ratelimit"
+)
+
+func buildTestRouter(spec *openapi.Document) (*gin.Engine, error) {
+	return buildTestRouterWith(config.Defaults(), spec)
+}
+
+func buildTestRouterWith(cfg *config.Config, spec *openapi.Document) (*gin.Engine, error) {
+	gin.SetMode(gin.TestMode)
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	// Only the route table is under test, so the handlers need no services
+	return newRouter(cfg, routeHandlers{}, ratelimit.NewMemoryLimiter(), spec, log)
+}
+
+func testSpec(t *testing.T) *openapi.Document {
+	t.Helper()
+	spec, err := handlers.APISpec()
+	if err != nil {
+		t.Fatalf("APISpec: %v", err)
+	}
+	return spec
+}
+
+// withPaths returns a copy of spec whose paths can be changed without touching spec
+func withPaths(spec *openapi.Document) *openapi.Document {
+	doc := *spec
+	doc.Paths = make(map[string]*openapi.PathItem, len(spec.Paths))
+	for path, item := range spec.Paths {
+		copied := make(openapi.PathItem, len(*item))
+		for method, op := range *item {
+			copied[method] = op
+		}
+		doc.Paths[path] = &copied
+	}
+	return &doc
+}
+
+func TestRouterMatchesOpenAPISpec(t *testing.T) {
+	if _, err := buildTestRouter(testSpec(t)); err != nil {
+		t.Fatalf("router and spec disagree: %v", err)
+	}
+}
+
+func TestRouterRejectsRouteMissingFromSpec(t *testing.T) {
+	spec := withPaths(testSpec(t))
+	delete(*spec.Paths["/api/v1/reward"], "post")
+
+	_, err := buildTestRouter(spec)
+	if err == nil {
+		t.Fatal("router accepted a spec without POST /api/v1/reward")
+	}
+	if !strings.Contains(err.Error(), "POST /api/v1/reward is not in the spec") {
+		t.Errorf("error does not name the route: %v", err)
+	}
+}
+
+func TestRouterRejectsSpecPathWithoutRoute(t *testing.T) {
+	spec := withPaths(testSpec(t))
+	spec.Paths["/api/v1/unrouted"] = &openapi.PathItem{"get": {OperationID: "unrouted"}}
+
+	_, err := buildTestRouter(spec)
+	if err == nil {
+		t.Fatal("router accepted a spec path it does not serve")
+	}
+	if !strings.Contains(err.Error(), "GET /api/v1/unrouted is in the spec but not routed") {
+		t.Errorf("error does not name the path: %v", err)
+	}
+}
+
+func TestAdminRoutesNeedToken(t *testing.T) {
+	cfg := config.Defaults()
+	cfg.Server.AdminTokens = map[string]string{"ops": "admin-secret"}
+	router, err := buildTestRouterWith(cfg, testSpec(t))
+	if err != nil {
+		t.Fatalf("newRouter: %v", err)
+	}
+
+	var adminRoutes int
+	for _, route := range router.Routes() {
+		if strings.HasPrefix(route.Path, "/api/v1/webhooks") {
+			t.Errorf("%s %s is outside /api/v1/admin", route.Method, route.Path)
+		}
+		if !strings.HasPrefix(route.Path, "/api/v1/admin/") {
+			continue
+		}
+		adminRoutes++
+		path := strings.ReplaceAll(route.Path, ":id", "1")
+		for _, auth := range []string{"", "Bearer wrong", "Basic admin-secret"} {
+			req := httptest.NewRequest(route.Method, path, nil)
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("%s %s with %q: status %d, want 401", route.Method, path, auth, w.Code)
+			}
+		}
+	}
+	if adminRoutes == 0 {
+		t.Fatal("no admin routes registered")
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/config"
+	"github.com/stocky/assignment/internal/database"
+	"github.com/stocky/assignment/internal/handlers"
+	"github.com/stocky/assignment/internal/ratelimit"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/services"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// emptyDriver answers every query with no rows, so the SQL spans can be
+// recorded without a database
+type emptyDriver struct{}
+
+func (emptyDriver) Open(string) (driver.Conn, error) { return emptyConn{}, nil }
+
+type emptyConn struct{}
+
+func (emptyConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (emptyConn) Close() error                        { return nil }
+func (emptyConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (emptyConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func init() {
+	sql.Register("stocky-empty", emptyDriver{})
+}
+
+func TestRequestServiceAndSQLSpansAreLinked(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer provider.Shutdown(context.Background())
+	otel.SetTracerProvider(provider)
+
+	db, err := database.Open("stocky-empty", "")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	gin.SetMode(gin.TestMode)
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	// Only the historical INR read is exercised, so the write-side dependencies stay nil
+	rewardService := services.NewRewardService(
+		repository.NewTxManager(db), repository.NewRewardRepository(db), repository.NewStockRepository(db),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		services.RewardBudgetConfig{}, nil, log,
+	)
+	router, err := newRouter(config.Defaults(), routeHandlers{reward: handlers.NewRewardHandler(rewardService, log)},
+		ratelimit.NewMemoryLimiter(), testSpec(t), log)
+	if err != nil {
+		t.Fatalf("newRouter: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/historical-inr/ravi", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, want 200: %s", w.Code, w.Body)
+	}
+
+	var request, service, query sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		switch {
+		case span.Name() == "GET /api/v1/historical-inr/:userId":
+			request = span
+		case span.Name() == "RewardService.GetHistoricalINR":
+			service = span
+		case strings.Contains(span.Name(), "query"):
+			query = span
+		}
+	}
+	if request == nil || service == nil || query == nil {
+		t.Fatalf("missing spans: request %v, service %v, query %v", request != nil, service != nil, query != nil)
+	}
+
+	if request.SpanKind() != trace.SpanKindServer || request.Parent().IsValid() {
+		t.Errorf("request span is not a root server span: kind %v, parent %v", request.SpanKind(), request.Parent().SpanID())
+	}
+	if got, want := service.Parent().SpanID(), request.SpanContext().SpanID(); got != want {
+		t.Errorf("service span parent = %s, want the request span %s", got, want)
+	}
+	if got, want := query.Parent().SpanID(), service.SpanContext().SpanID(); got != want {
+		t.Errorf("SQL span parent = %s, want the service span %s", got, want)
+	}
+	for _, span := range []sdktrace.ReadOnlySpan{service, query} {
+		if span.SpanContext().TraceID() != request.SpanContext().TraceID() {
+			t.Errorf("%s is in trace %s, want %s", span.Name(), span.SpanContext().TraceID(), request.SpanContext().TraceID())
+		}
+	}
+}
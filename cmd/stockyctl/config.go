@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/stocky/assignment/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// runConfigPrint prints the configuration the server would start with, after the
+// config file and environment overrides are applied. It exits non-zero when the
+// configuration is invalid, listing every problem after the printed values.
+func runConfigPrint(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("config print")
+	if err := a.parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	cfg, envErr := config.Resolve()
+	if cfg == nil {
+		return envErr
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg.Redacted()); err != nil {
+		return err
+	}
+	if a.asJSON {
+		// Round-trip through YAML so JSON keys match the config file's
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(out.Bytes(), &doc); err != nil {
+			return err
+		}
+		if err := a.print(doc, nil); err != nil {
+			return err
+		}
+	} else {
+		if path := os.Getenv(config.ConfigFileEnv); path != "" {
+			fmt.Printf("# config file: %s\n", path)
+		}
+		os.Stdout.Write(out.Bytes())
+	}
+
+	if err := errors.Join(envErr, cfg.Validate()); err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return nil
+}
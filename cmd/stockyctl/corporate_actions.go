@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/services"
+)
+
+func runCorporateActionsList(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("corporate-actions list")
+	pending := fs.Bool("pending", false, "only list events that have not been applied")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	events, err := a.corporateActionService().ListActions(ctx, *pending)
+	if err != nil {
+		return err
+	}
+
+	return a.print(events, func() {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSYMBOL\tTYPE\tDATE\tTERMS\tPROCESSED")
+		for _, event := range events {
+			processed := "no"
+			if event.ProcessedAt.Valid {
+				processed = event.ProcessedAt.Time.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", event.ID, event.StockSymbol, event.EventType,
+				event.EventDate.Format("2006-01-02"), eventTerms(&event), processed)
+		}
+		w.Flush()
+	})
+}
+
+func runCorporateActionsAdd(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("corporate-actions add")
+	symbol := fs.String("symbol", "", "stock the event applies to")
+	eventType := fs.String("type", "", "split, bonus, merger or delisting")
+	date := fs.String("date", "", "effective date, YYYY-MM-DD")
+	ratio := fs.String("ratio", "", "split: OLD:NEW shares (1:10); bonus: HELD:BONUS (1:1 gives one bonus share per share held)")
+	into := fs.String("into", "", "merger: surviving stock")
+	conversion := fs.Float64("conversion", 0, "merger: shares of the surviving stock per merged share")
+	description := fs.String("description", "", "free-text note")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	eventDate, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		return fmt.Errorf("invalid -date %q, want YYYY-MM-DD", *date)
+	}
+	req := &services.CorporateActionRequest{
+		StockSymbol:      *symbol,
+		EventType:        strings.ToLower(*eventType),
+		EventDate:        eventDate,
+		MergedIntoSymbol: *into,
+		ConversionRatio:  *conversion,
+		Description:      *description,
+	}
+	if *ratio != "" {
+		if req.RatioOld, req.RatioNew, err = parseRatio(*ratio); err != nil {
+			return err
+		}
+	}
+
+	event, err := a.corporateActionService().CreateAction(ctx, req, a.dryRun)
+	if err != nil {
+		return err
+	}
+
+	return a.print(event, func() {
+		if a.dryRun {
+			fmt.Printf("%sWould record %s %s on %s (%s)\n", dryRunPrefix(true),
+				event.StockSymbol, event.EventType, event.EventDate.Format("2006-01-02"), eventTerms(event))
+			return
+		}
+		fmt.Printf("Recorded stock event %d: %s %s on %s (%s). Apply it with 'stockyctl corporate-actions apply -id %d'.\n",
+			event.ID, event.StockSymbol, event.EventType, event.EventDate.Format("2006-01-02"), eventTerms(event), event.ID)
+	})
+}
+
+// runCorporateActionsApply applies one event, or every due event in date order
+func runCorporateActionsApply(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("corporate-actions apply")
+	id := fs.Int64("id", 0, "apply only this stock event")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	service := a.corporateActionService()
+	var results []services.CorporateActionResult
+	var applyErr error
+	if *id > 0 {
+		var result *services.CorporateActionResult
+		if result, applyErr = service.ApplyAction(ctx, *id, a.dryRun); applyErr == nil {
+			results = append(results, *result)
+		}
+	} else {
+		results, applyErr = service.ApplyDueActions(ctx, a.dryRun)
+	}
+
+	// Print what was applied before a failure stopped the run
+	if err := a.print(results, func() { printCorporateActionResults(results, a.dryRun) }); err != nil {
+		return err
+	}
+	return applyErr
+}
+
+func printCorporateActionResults(results []services.CorporateActionResult, dryRun bool) {
+	if len(results) == 0 {
+		fmt.Println("No corporate actions are due")
+		return
+	}
+
+	for _, result := range results {
+		event := result.Event
+		fmt.Printf("%sEvent %d: %s %s (%s): %d holdings, %d tax lots, %d vesting schedules adjusted\n",
+			dryRunPrefix(dryRun), event.ID, event.StockSymbol, event.EventType, eventTerms(&event),
+			len(result.Adjustments), result.TaxLotsAdjusted, result.VestingSchedulesAdjusted)
+		if len(result.Adjustments) == 0 {
+			continue
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "  USER\tSYMBOL\tKIND\tSHARES\tNEW SHARES\tAVG PRICE\tNEW AVG PRICE")
+		for _, adj := range result.Adjustments {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%.6f\t%.6f\t%.4f\t%.4f\n", adj.UserID, adj.StockSymbol, adj.Kind,
+				adj.PreviousShares, adj.NewShares, adj.PreviousAveragePrice, adj.NewAveragePrice)
+		}
+		w.Flush()
+	}
+}
+
+// eventTerms describes the ratio of a stock event
+func eventTerms(event *models.StockEvent) string {
+	switch event.EventType {
+	case services.CorporateActionSplit:
+		return fmt.Sprintf("%d:%d", event.SplitRatioOld.Int64, event.SplitRatioNew.Int64)
+	case services.CorporateActionBonus:
+		return fmt.Sprintf("%d bonus per %d held", event.SplitRatioNew.Int64, event.SplitRatioOld.Int64)
+	case services.CorporateActionMerger:
+		return fmt.Sprintf("%g %s per share", event.ConversionRatio.Float64, event.MergedIntoSymbol.String)
+	}
+	return "-"
+}
+
+func parseRatio(raw string) (int64, int64, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("-ratio must look like 1:10")
+	}
+	before, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid -ratio %q", raw)
+	}
+	after, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid -ratio %q", raw)
+	}
+	return before, after, nil
+}
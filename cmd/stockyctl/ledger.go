@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// runLedgerRepost posts ledger entries for rewards that have none, oldest first
+func runLedgerRepost(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("ledger repost")
+	limit := fs.Int("limit", 1000, "maximum number of rewards to repost")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+	if *limit <= 0 {
+		return errors.New("-limit must be positive")
+	}
+
+	reposts, err := a.rewardService().RepostLedgerEntries(ctx, *limit, a.dryRun)
+
+	// Print what was posted before a failure stopped the run
+	if printErr := a.print(reposts, func() {
+		if len(reposts) == 0 {
+			fmt.Println("Every reward has ledger entries")
+			return
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "REWARD\tUSER\tSYMBOL\tVALUE\tFEES\tENTRY GROUP")
+		for _, r := range reposts {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%.4f\t%.4f\t%s\n",
+				r.RewardEventID, r.UserID, r.StockSymbol, r.TotalValue, r.TotalFees, r.EntryGroupID)
+		}
+		w.Flush()
+		fmt.Printf("%s%d rewards reposted\n", dryRunPrefix(a.dryRun), len(reposts))
+	}); printErr != nil {
+		return printErr
+	}
+	return err
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type seededListing struct {
+	Exchange string `json:"exchange"`
+	Symbol   string `json:"symbol"`
+	ISIN     string `json:"isin"`
+	IsActive bool   `json:"is_active"`
+	Action   string `json:"action"`
+	Previous string `json:"previous_symbol,omitempty"` // Set when the ticker was renamed
+}
+
+type listingSeedReport struct {
+	DryRun   bool            `json:"dry_run"`
+	Listings []seededListing `json:"listings"`
+}
+
+func runListingsList(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("listings list")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	listings, err := repository.NewStockRepository(a.db).ListListings(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.print(listings, func() {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "EXCHANGE\tSYMBOL\tISIN\tSTOCK\tACTIVE")
+		for _, listing := range listings {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", listing.Exchange, listing.Symbol, listing.ISIN, listing.StockSymbol, listing.IsActive)
+		}
+		w.Flush()
+	})
+}
+
+// runListingsSeed upserts listings from a CSV file. A stock has one listing per exchange,
+// so a row with a new ticker for an existing (isin, exchange) records a rename; rewards
+// and holdings keep the stock's own symbol and are unaffected.
+func runListingsSeed(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("listings seed")
+	file := fs.String("file", "", "CSV of exchange,symbol,isin[,active] (a header row is optional)")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+
+	rows, err := readCSV(*file, "exchange")
+	if err != nil {
+		return err
+	}
+
+	stockRepo := repository.NewStockRepository(a.db)
+	auditRepo := repository.NewAuditRepository(a.db)
+	stocks, err := stockRepo.ListStocks(ctx)
+	if err != nil {
+		return err
+	}
+	isins := make(map[string]bool, len(stocks))
+	for _, stock := range stocks {
+		if stock.ISIN != "" {
+			isins[stock.ISIN] = true
+		}
+	}
+	existing, err := stockRepo.ListListings(ctx)
+	if err != nil {
+		return err
+	}
+	byISIN := make(map[string]models.StockListing, len(existing))
+	for _, listing := range existing {
+		byISIN[listing.ISIN+":"+listing.Exchange] = listing
+	}
+
+	report := listingSeedReport{DryRun: a.dryRun}
+	for i, row := range rows {
+		if len(row) < 3 {
+			return fmt.Errorf("%s row %d: want exchange,symbol,isin[,active]", *file, i+1)
+		}
+		listing := models.StockListing{
+			Exchange: strings.ToUpper(strings.TrimSpace(row[0])),
+			Symbol:   strings.ToUpper(strings.TrimSpace(row[1])),
+			ISIN:     strings.ToUpper(strings.TrimSpace(row[2])),
+			IsActive: true,
+		}
+		if len(row) > 3 && strings.TrimSpace(row[3]) != "" {
+			active, err := strconv.ParseBool(strings.TrimSpace(row[3]))
+			if err != nil {
+				return fmt.Errorf("%s row %d: invalid active flag %q", *file, i+1, row[3])
+			}
+			listing.IsActive = active
+		}
+		if !services.ValidExchange(listing.Exchange) {
+			return fmt.Errorf("%s row %d: exchange must be %s or %s", *file, i+1, services.ExchangeNSE, services.ExchangeBSE)
+		}
+		if listing.Symbol == "" {
+			return fmt.Errorf("%s row %d: symbol is required", *file, i+1)
+		}
+		if !services.ValidISIN(listing.ISIN) {
+			return fmt.Errorf("%s row %d: invalid ISIN %s", *file, i+1, listing.ISIN)
+		}
+		if !isins[listing.ISIN] {
+			return fmt.Errorf("%s row %d: no stock has ISIN %s; add it with stocks seed first", *file, i+1, listing.ISIN)
+		}
+
+		action := seedCreate
+		previous := ""
+		var before *models.StockListing
+		if current, ok := byISIN[listing.ISIN+":"+listing.Exchange]; ok {
+			action = seedUpdate
+			before = &current
+			if current.Symbol != listing.Symbol {
+				previous = current.Symbol
+			}
+			if current.Symbol == listing.Symbol && current.IsActive == listing.IsActive {
+				action = seedUnchanged
+			}
+		}
+
+		if !a.dryRun && action != seedUnchanged {
+			err := a.txManager().WithinTx(ctx, func(tx *sql.Tx) error {
+				if err := stockRepo.WithTx(tx).UpsertListing(ctx, &listing); err != nil {
+					return err
+				}
+				entityID := listing.Exchange + ":" + listing.ISIN
+				return services.RecordAudit(ctx, auditRepo.WithTx(tx), services.AuditListingUpsert, "stock_listing", entityID, before, listing)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save %s:%s: %w", listing.Exchange, listing.Symbol, err)
+			}
+		}
+		report.Listings = append(report.Listings, seededListing{
+			Exchange: listing.Exchange,
+			Symbol:   listing.Symbol,
+			ISIN:     listing.ISIN,
+			IsActive: listing.IsActive,
+			Action:   action,
+			Previous: previous,
+		})
+	}
+
+	return a.print(report, func() {
+		counts := make(map[string]int)
+		for _, listing := range report.Listings {
+			counts[listing.Action]++
+			switch {
+			case listing.Action == seedUnchanged:
+			case listing.Previous != "":
+				fmt.Printf("%-7s %s:%s (%s, renamed from %s)\n", listing.Action, listing.Exchange, listing.Symbol, listing.ISIN, listing.Previous)
+			default:
+				fmt.Printf("%-7s %s:%s (%s)\n", listing.Action, listing.Exchange, listing.Symbol, listing.ISIN)
+			}
+		}
+		fmt.Printf("%s%d created, %d updated, %d unchanged\n",
+			dryRunPrefix(a.dryRun), counts[seedCreate], counts[seedUpdate], counts[seedUnchanged])
+	})
+}
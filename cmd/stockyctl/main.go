@@ -0,0 +1,249 @@
+// Command stockyctl runs operational tasks against the Stocky database: seeding
+// stocks and listings, backfilling prices, applying corporate actions, reconciling holdings and
+// reposting failed ledger entries.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/config"
+	"github.com/stocky/assignment/internal/database"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// Exit codes: 0 success, 1 failure or bad usage, 2 the command found problems it did not fix
+const (
+	exitFailure  = 1
+	exitProblems = 2
+)
+
+// errProblemsRemain makes a command exit with exitProblems after printing its output
+var errProblemsRemain = errors.New("problems remain")
+
+// command is a stockyctl subcommand. run receives the arguments after the command name.
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, app *app, args []string) error
+}
+
+var commands = []command{
+	{"stocks list", "List stocks", runStocksList},
+	{"stocks seed", "Create or update stocks from a CSV of symbol,company_name,exchange,isin", runStocksSeed},
+	{"listings list", "List exchange listings of stocks", runListingsList},
+	{"listings seed", "Create listings or record ticker renames from a CSV of exchange,symbol,isin[,active]", runListingsSeed},
+	{"prices backfill", "Load historical prices from a CSV of symbol,timestamp,price[,source]; symbol may be exchange:symbol", runPricesBackfill},
+	{"corporate-actions list", "List stock events", runCorporateActionsList},
+	{"corporate-actions add", "Record a stock split, bonus issue, merger or delisting", runCorporateActionsAdd},
+	{"corporate-actions apply", "Apply one event, or every due event when -id is omitted", runCorporateActionsApply},
+	{"reconcile", "Compare holdings with the event log and optionally repair them", runReconcile},
+	{"ledger repost", "Post ledger entries for rewards whose posting failed", runLedgerRepost},
+	{"config print", "Show the effective configuration with secrets redacted, then validate it", runConfigPrint},
+}
+
+// app holds what every command shares: output options, configuration and the
+// database connection, which is opened once the command's flags have parsed
+type app struct {
+	dryRun bool
+	asJSON bool
+
+	cfg *config.Config
+	db  *sql.DB
+	log *logrus.Logger
+}
+
+func main() {
+	cmd, args := findCommand(os.Args[1:])
+	if cmd == nil {
+		usage()
+		os.Exit(exitFailure)
+	}
+
+	log := logrus.New()
+	log.SetOutput(os.Stderr)
+	log.SetLevel(logrus.WarnLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	ctx = requestctx.WithActor(ctx, operator())
+	a := &app{log: log}
+	err := cmd.run(ctx, a, args)
+	stop()
+	a.close()
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errProblemsRemain):
+		os.Exit(exitProblems)
+	default:
+		fmt.Fprintf(os.Stderr, "stockyctl %s: %v\n", cmd.name, err)
+		os.Exit(exitFailure)
+	}
+}
+
+// operator names whoever runs stockyctl in the audit log
+func operator() string {
+	if user := os.Getenv("USER"); user != "" {
+		return "stockyctl:" + user
+	}
+	return "stockyctl"
+}
+
+// findCommand matches the longest command name at the start of args
+func findCommand(args []string) (*command, []string) {
+	for i := range commands {
+		words := strings.Fields(commands[i].name)
+		if len(args) < len(words) {
+			continue
+		}
+		if strings.Join(args[:len(words)], " ") == commands[i].name {
+			return &commands[i], args[len(words):]
+		}
+	}
+	return nil, nil
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: stockyctl <command> [flags]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	sorted := append([]command(nil), commands...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	for _, cmd := range sorted {
+		fmt.Fprintf(os.Stderr, "  %-26s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr, "\nEvery command accepts -dry-run (report what would change, write nothing) and -json.")
+	fmt.Fprintln(os.Stderr, "Run 'stockyctl <command> -h' for its flags.")
+}
+
+// parse parses a command's flags, then loads the configuration and connects to the database
+func (a *app) parse(ctx context.Context, fs *flag.FlagSet, args []string) error {
+	if err := a.parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	db, err := database.Connect(ctx, cfg.Database.GetDSN(), database.PoolFromConfig(cfg.Database), a.log)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	a.cfg, a.db = cfg, db
+	return nil
+}
+
+// parseFlags parses a command's flags, including the shared -dry-run and -json
+func (a *app) parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.BoolVar(&a.dryRun, "dry-run", false, "report what would change without writing anything")
+	fs.BoolVar(&a.asJSON, "json", false, "print machine-readable JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	return nil
+}
+
+func (a *app) close() {
+	if a.db != nil {
+		a.db.Close()
+	}
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("stockyctl "+name, flag.ContinueOnError)
+}
+
+// print writes v as JSON with -json, otherwise calls text
+func (a *app) print(v interface{}, text func()) error {
+	if !a.asJSON {
+		text()
+		return nil
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (a *app) txManager() repository.TxManager {
+	return repository.NewTxManager(a.db)
+}
+
+func (a *app) rewardService() services.RewardService {
+	stockRepo := repository.NewStockRepository(a.db)
+	auditRepo := repository.NewAuditRepository(a.db)
+	feeService := services.NewFeeScheduleService(
+		a.txManager(),
+		repository.NewFeeScheduleRepository(a.db),
+		auditRepo,
+		services.FeesConfig{
+			BrokerageFeeBP: a.cfg.Fees.BrokerageFeeBP,
+			STTFeeBP:       a.cfg.Fees.STTFeeBP,
+			GSTFeePercent:  a.cfg.Fees.GSTFeePercent,
+			ExchangeFeeBP:  a.cfg.Fees.ExchangeFeeBP,
+			SEBIFeeBP:      a.cfg.Fees.SEBIFeeBP,
+		},
+		a.log,
+	)
+	return services.NewRewardService(
+		a.txManager(),
+		repository.NewRewardRepository(a.db),
+		stockRepo,
+		repository.NewLedgerRepository(a.db),
+		repository.NewTaxLotRepository(a.db),
+		repository.NewVestingRepository(a.db),
+		repository.NewWebhookRepository(a.db),
+		repository.NewBudgetRepository(a.db),
+		repository.NewRiskRepository(a.db),
+		auditRepo,
+		services.NewStockPriceService(stockRepo, services.NewPriceHub(), a.log),
+		feeService,
+		services.RewardBudgetConfig{
+			MaxRewardINR:   float64(a.cfg.Budgets.MaxRewardINR),
+			UserDailyINR:   float64(a.cfg.Budgets.UserDailyINR),
+			UserMonthlyINR: float64(a.cfg.Budgets.UserMonthlyINR),
+			GlobalDailyINR: float64(a.cfg.Budgets.GlobalDailyINR),
+			ReasonDailyINR: a.cfg.Budgets.ReasonDailyINRFloat(),
+		},
+		nil, // stockyctl never creates rewards, so it needs no risk checks
+		a.log,
+	)
+}
+
+func (a *app) corporateActionService() services.CorporateActionService {
+	return services.NewCorporateActionService(
+		a.txManager(),
+		repository.NewCorporateActionRepository(a.db),
+		repository.NewRewardRepository(a.db),
+		repository.NewStockRepository(a.db),
+		repository.NewVestingRepository(a.db),
+		repository.NewWebhookRepository(a.db),
+		repository.NewAuditRepository(a.db),
+		a.log,
+	)
+}
+
+func (a *app) reconciliationService() services.ReconciliationService {
+	return services.NewReconciliationService(
+		a.txManager(),
+		repository.NewReconciliationRepository(a.db),
+		repository.NewRewardRepository(a.db),
+		repository.NewAuditRepository(a.db),
+		services.ReconciliationConfig{},
+		a.log,
+	)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// backfillSource tags backfilled rows when the CSV has no source column
+const backfillSource = "backfill"
+
+type backfillReport struct {
+	DryRun     bool      `json:"dry_run"`
+	Rows       int       `json:"rows"`
+	Symbols    []string  `json:"symbols"` // exchange:symbol of each listing
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
+	Partitions int       `json:"partitions_created"`
+	// Rows older than the retention window; the next retention run rolls them up into daily OHLC
+	Expired int `json:"expired"`
+}
+
+// runPricesBackfill loads price ticks from a CSV. Rows for an existing (listing, timestamp)
+// overwrite it, so a backfill can be re-run safely.
+func runPricesBackfill(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("prices backfill")
+	file := fs.String("file", "", "CSV of symbol,timestamp,price[,source]; symbol is Stocky's symbol for the primary listing or exchange:symbol, timestamps are RFC 3339 or YYYY-MM-DD")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+
+	rows, err := readCSV(*file, "symbol")
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%s has no rows", *file)
+	}
+
+	stockRepo := repository.NewStockRepository(a.db)
+	stocks, err := stockRepo.ListStocks(ctx)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]models.Stock, len(stocks))
+	for _, stock := range stocks {
+		known[stock.Symbol] = stock
+	}
+	listings, err := stockRepo.ListListings(ctx)
+	if err != nil {
+		return err
+	}
+	byTicker := make(map[string]models.StockListing, len(listings))
+	for _, listing := range listings {
+		byTicker[listing.Exchange+":"+listing.Symbol] = listing
+	}
+
+	// Validate everything before writing anything
+	prices := make([]models.StockPrice, 0, len(rows))
+	symbols := make(map[string]bool)
+	report := backfillReport{DryRun: a.dryRun, Rows: len(rows)}
+	cutoff := time.Now().AddDate(0, 0, -a.cfg.Service.PriceRetentionDays)
+	for i, row := range rows {
+		price, err := parsePriceRow(row)
+		if err != nil {
+			return fmt.Errorf("%s row %d: %w", *file, i+1, err)
+		}
+		// A plain symbol is the stock's primary listing; exchange:symbol names a listing
+		if exchange, symbol, ok := services.ParseListingRef(price.StockSymbol); ok {
+			listing, found := byTicker[exchange+":"+symbol]
+			if !found {
+				return fmt.Errorf("%s row %d: unknown listing %s:%s", *file, i+1, exchange, symbol)
+			}
+			price.StockSymbol, price.Exchange = listing.StockSymbol, listing.Exchange
+		} else {
+			stock, found := known[price.StockSymbol]
+			if !found {
+				return fmt.Errorf("%s row %d: unknown stock %s", *file, i+1, price.StockSymbol)
+			}
+			price.Exchange = stock.Exchange
+		}
+
+		if report.From.IsZero() || price.Timestamp.Before(report.From) {
+			report.From = price.Timestamp
+		}
+		if price.Timestamp.After(report.To) {
+			report.To = price.Timestamp
+		}
+		if price.Timestamp.Before(cutoff) {
+			report.Expired++
+		}
+		if listing := price.Exchange + ":" + price.StockSymbol; !symbols[listing] {
+			symbols[listing] = true
+			report.Symbols = append(report.Symbols, listing)
+		}
+		prices = append(prices, price)
+	}
+
+	if !a.dryRun {
+		// Ticks outside every monthly partition would land in stock_prices_default
+		report.Partitions, err = repository.NewPriceRetentionRepository(a.db).EnsurePartitions(ctx, report.From, report.To)
+		if err != nil {
+			return fmt.Errorf("failed to create partitions: %w", err)
+		}
+		for i := range prices {
+			if err := stockRepo.CreateStockPrice(ctx, &prices[i]); err != nil {
+				return fmt.Errorf("failed to save %s:%s at %s: %w",
+					prices[i].Exchange, prices[i].StockSymbol, prices[i].Timestamp.Format(time.RFC3339), err)
+			}
+		}
+	}
+
+	return a.print(report, func() {
+		fmt.Printf("%s%d prices for %s from %s to %s\n",
+			dryRunPrefix(a.dryRun), report.Rows, strings.Join(report.Symbols, ", "),
+			report.From.Format(time.RFC3339), report.To.Format(time.RFC3339))
+		if report.Partitions > 0 {
+			fmt.Printf("%d stock_prices partitions created\n", report.Partitions)
+		}
+		if report.Expired > 0 {
+			fmt.Printf("%d prices are older than the %d-day retention window and will be rolled up into daily OHLC on the next retention run\n",
+				report.Expired, a.cfg.Service.PriceRetentionDays)
+		}
+	})
+}
+
+func parsePriceRow(row []string) (models.StockPrice, error) {
+	if len(row) < 3 {
+		return models.StockPrice{}, errors.New("want symbol,timestamp,price[,source]")
+	}
+
+	price := models.StockPrice{
+		StockSymbol: strings.ToUpper(strings.TrimSpace(row[0])),
+		Source:      backfillSource,
+	}
+	if len(row) > 3 && strings.TrimSpace(row[3]) != "" {
+		price.Source = strings.TrimSpace(row[3])
+	}
+
+	raw := strings.TrimSpace(row[1])
+	ts, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		if ts, err = time.Parse("2006-01-02", raw); err != nil {
+			return price, fmt.Errorf("invalid timestamp %q", raw)
+		}
+	}
+	price.Timestamp = ts
+
+	if price.Price, err = strconv.ParseFloat(strings.TrimSpace(row[2]), 64); err != nil || price.Price <= 0 {
+		return price, fmt.Errorf("invalid price %q", row[2])
+	}
+	return price, nil
+}
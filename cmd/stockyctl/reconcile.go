@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/stocky/assignment/internal/services"
+)
+
+// runReconcile replays the event log and compares it with user_holdings. It exits
+// with exitProblems when mismatches remain unrepaired.
+func runReconcile(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("reconcile")
+	userID := fs.String("user", "", "only reconcile this user's holdings")
+	repair := fs.Bool("repair", false, "overwrite drifted holdings with recomputed values (audited)")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	report, err := a.reconciliationService().Reconcile(ctx, services.ReconcileOptions{
+		UserID: *userID,
+		Repair: *repair,
+		DryRun: a.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := a.print(report, func() { printReconciliationReport(report) }); err != nil {
+		return err
+	}
+	if len(report.Mismatches) > report.Repaired {
+		return errProblemsRemain
+	}
+	return nil
+}
+
+func printReconciliationReport(report *services.ReconciliationReport) {
+	// A dry run records no run, so it has no id to show
+	run := "Run " + report.RunID
+	if report.DryRun {
+		run = dryRunPrefix(true) + "Run"
+	}
+	fmt.Printf("%s: checked %d holdings, %d mismatches, %d repaired\n",
+		run, report.HoldingsChecked, len(report.Mismatches), report.Repaired)
+	if len(report.Mismatches) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nUSER\tSYMBOL\tSHARES\tEXPECTED\tAVG PRICE\tEXPECTED\tSTATUS")
+	for _, m := range report.Mismatches {
+		status := "mismatch"
+		switch {
+		case m.Repaired:
+			status = "repaired"
+		case m.SkipReason != "":
+			status = "skipped: " + m.SkipReason
+		case m.MissingHolding:
+			status = "missing holding"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%.6f\t%.6f\t%.4f\t%.4f\t%s\n",
+			m.UserID, m.StockSymbol, m.ActualShares, m.ExpectedShares,
+			m.ActualAveragePrice, m.ExpectedAveragePrice, status)
+	}
+	w.Flush()
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// Seed actions
+const (
+	seedCreate    = "create"
+	seedUpdate    = "update"
+	seedUnchanged = "unchanged"
+)
+
+type seededStock struct {
+	Symbol      string `json:"symbol"`
+	ISIN        string `json:"isin,omitempty"`
+	CompanyName string `json:"company_name"`
+	Exchange    string `json:"exchange"`
+	Action      string `json:"action"`
+}
+
+type seedReport struct {
+	DryRun bool          `json:"dry_run"`
+	Stocks []seededStock `json:"stocks"`
+}
+
+func runStocksList(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("stocks list")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+
+	stocks, err := repository.NewStockRepository(a.db).ListStocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.print(stocks, func() {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SYMBOL\tISIN\tCOMPANY\tEXCHANGE\tACTIVE")
+		for _, stock := range stocks {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", stock.Symbol, stock.ISIN, stock.CompanyName, stock.Exchange, stock.IsActive)
+		}
+		w.Flush()
+	})
+}
+
+// runStocksSeed upserts stocks from a CSV file. Existing stocks keep their active flag,
+// and their ISIN when the row has none.
+func runStocksSeed(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("stocks seed")
+	file := fs.String("file", "", "CSV of symbol,company_name[,exchange[,isin]] (a header row is optional)")
+	if err := a.parse(ctx, fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+
+	rows, err := readCSV(*file, "symbol")
+	if err != nil {
+		return err
+	}
+
+	stockRepo := repository.NewStockRepository(a.db)
+	auditRepo := repository.NewAuditRepository(a.db)
+	existing, err := stockRepo.ListStocks(ctx)
+	if err != nil {
+		return err
+	}
+	bySymbol := make(map[string]models.Stock, len(existing))
+	for _, stock := range existing {
+		bySymbol[stock.Symbol] = stock
+	}
+
+	report := seedReport{DryRun: a.dryRun}
+	for i, row := range rows {
+		if len(row) < 2 {
+			return fmt.Errorf("%s row %d: want symbol,company_name[,exchange[,isin]]", *file, i+1)
+		}
+		stock := models.Stock{
+			Symbol:      strings.ToUpper(strings.TrimSpace(row[0])),
+			CompanyName: strings.TrimSpace(row[1]),
+			Exchange:    "NSE",
+		}
+		if len(row) > 2 && strings.TrimSpace(row[2]) != "" {
+			stock.Exchange = strings.ToUpper(strings.TrimSpace(row[2]))
+		}
+		if len(row) > 3 {
+			stock.ISIN = strings.ToUpper(strings.TrimSpace(row[3]))
+		}
+		if stock.Symbol == "" || stock.CompanyName == "" {
+			return fmt.Errorf("%s row %d: symbol and company_name are required", *file, i+1)
+		}
+		if !services.ValidExchange(stock.Exchange) {
+			return fmt.Errorf("%s row %d: exchange must be %s or %s", *file, i+1, services.ExchangeNSE, services.ExchangeBSE)
+		}
+		if stock.ISIN != "" && !services.ValidISIN(stock.ISIN) {
+			return fmt.Errorf("%s row %d: invalid ISIN %s", *file, i+1, stock.ISIN)
+		}
+
+		action := seedCreate
+		var before *models.Stock
+		if current, ok := bySymbol[stock.Symbol]; ok {
+			action = seedUpdate
+			before = &current
+			if stock.ISIN == "" {
+				stock.ISIN = current.ISIN
+			}
+			if current.CompanyName == stock.CompanyName && current.Exchange == stock.Exchange && current.ISIN == stock.ISIN {
+				action = seedUnchanged
+			}
+		}
+
+		if !a.dryRun && action != seedUnchanged {
+			err := a.txManager().WithinTx(ctx, func(tx *sql.Tx) error {
+				if err := stockRepo.WithTx(tx).UpsertStock(ctx, &stock); err != nil {
+					return err
+				}
+				return services.RecordAudit(ctx, auditRepo.WithTx(tx), services.AuditStockUpsert, "stock", stock.Symbol, before, stock)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save %s: %w", stock.Symbol, err)
+			}
+		}
+		report.Stocks = append(report.Stocks, seededStock{
+			Symbol:      stock.Symbol,
+			ISIN:        stock.ISIN,
+			CompanyName: stock.CompanyName,
+			Exchange:    stock.Exchange,
+			Action:      action,
+		})
+	}
+
+	return a.print(report, func() {
+		counts := make(map[string]int)
+		for _, stock := range report.Stocks {
+			counts[stock.Action]++
+			if stock.Action != seedUnchanged {
+				fmt.Printf("%-7s %s (%s, %s)\n", stock.Action, stock.Symbol, stock.CompanyName, stock.Exchange)
+			}
+		}
+		fmt.Printf("%s%d created, %d updated, %d unchanged\n",
+			dryRunPrefix(a.dryRun), counts[seedCreate], counts[seedUpdate], counts[seedUnchanged])
+	})
+}
+
+// readCSV reads every record of a CSV file, skipping a header row whose first
+// column is headerFirst
+func readCSV(path, headerFirst string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+
+	var rows [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if len(rows) == 0 && len(record) > 0 && strings.EqualFold(strings.TrimSpace(record[0]), headerFirst) {
+			continue
+		}
+		rows = append(rows, record)
+	}
+	return rows, nil
+}
+
+func dryRunPrefix(dryRun bool) string {
+	if dryRun {
+		return "[dry run] "
+	}
+	return ""
+}
@@ -1,80 +1,533 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
 )
 
+// ConfigFileEnv names the environment variable holding the path of an optional
+// config file, read as TOML when it ends in .toml and as YAML otherwise
+const ConfigFileEnv = "CONFIG_FILE"
+
+// redacted replaces secrets in Redacted output
+const redacted = "********"
+
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Fees     FeesConfig
-	Service  ServiceConfig
+	Server    ServerConfig    `yaml:"server" toml:"server"`
+	Database  DatabaseConfig  `yaml:"database" toml:"database"`
+	Fees      FeesConfig      `yaml:"fees" toml:"fees"`
+	Service   ServiceConfig   `yaml:"service" toml:"service"`
+	RateLimit RateLimitConfig `yaml:"rate_limit" toml:"rate_limit"`
+	Budgets   BudgetConfig    `yaml:"budgets" toml:"budgets"`
+	Risk      RiskConfig      `yaml:"risk" toml:"risk"`
+	Tracing   TracingConfig   `yaml:"tracing" toml:"tracing"`
 }
 
 type ServerConfig struct {
-	Port    string
-	GinMode string
+	Port                   string            `yaml:"port" toml:"port"`
+	GinMode                string            `yaml:"gin_mode" toml:"gin_mode"`
+	ShutdownTimeoutSeconds int               `yaml:"shutdown_timeout_seconds" toml:"shutdown_timeout_seconds"` // How long to wait for in-flight requests on shutdown
+	RequestTimeoutSeconds  int               `yaml:"request_timeout_seconds" toml:"request_timeout_seconds"`   // Per-request deadline propagated to the database
+	GRPCPort               string            `yaml:"grpc_port" toml:"grpc_port"`                               // Port of the gRPC API for internal services; empty disables it
+	AdminTokens            map[string]string `yaml:"admin_tokens" toml:"admin_tokens"`                         // Bearer token of each admin, by name, for /api/v1/admin; none disables those routes
 }
 
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host     string `yaml:"host" toml:"host"`
+	Port     string `yaml:"port" toml:"port"`
+	User     string `yaml:"user" toml:"user"`
+	Password string `yaml:"password" toml:"password"`
+	DBName   string `yaml:"name" toml:"name"`
+	SSLMode  string `yaml:"sslmode" toml:"sslmode"`
+
+	MaxOpenConns           int `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxIdleConns           int `yaml:"max_idle_conns" toml:"max_idle_conns"`
+	ConnMaxLifetimeMinutes int `yaml:"conn_max_lifetime_minutes" toml:"conn_max_lifetime_minutes"` // 0 keeps connections forever
+	ConnMaxIdleTimeMinutes int `yaml:"conn_max_idle_time_minutes" toml:"conn_max_idle_time_minutes"`
+	ConnectTimeoutSeconds  int `yaml:"connect_timeout_seconds" toml:"connect_timeout_seconds"` // How long startup retries an unreachable database
+
+	// ReplicaDSN is an optional read replica for read-only endpoints. It uses the primary's pool settings.
+	ReplicaDSN string `yaml:"replica_dsn" toml:"replica_dsn"`
 }
 
 type FeesConfig struct {
-	BrokerageFeeBP int // Basis points (1 bp = 0.01%)
-	STTFeeBC       int
-	GSTFeeBC       int
-	ExchangeFeeBC  int
-	SEBIFeeBC      int
+	BrokerageFeeBP int `yaml:"brokerage_fee_bp" toml:"brokerage_fee_bp"` // Basis points (1 bp = 0.01%)
+	STTFeeBP       int `yaml:"stt_fee_bp" toml:"stt_fee_bp"`
+	GSTFeePercent  int `yaml:"gst_fee_percent" toml:"gst_fee_percent"` // Percent of brokerage + exchange + SEBI charges, not basis points
+	ExchangeFeeBP  int `yaml:"exchange_fee_bp" toml:"exchange_fee_bp"`
+	SEBIFeeBP      int `yaml:"sebi_fee_bp" toml:"sebi_fee_bp"`
 }
 
 type ServiceConfig struct {
-	PriceUpdateIntervalMinutes int
+	PriceUpdateIntervalMinutes  int  `yaml:"price_update_interval_minutes" toml:"price_update_interval_minutes"`
+	PriceMaxAgeMinutes          int  `yaml:"price_max_age_minutes" toml:"price_max_age_minutes"`     // Readiness fails when the latest price is older than this
+	ReadinessDBLatencyMs        int  `yaml:"readiness_db_latency_ms" toml:"readiness_db_latency_ms"` // Readiness fails when a DB ping takes longer than this
+	SettlementDays              int  `yaml:"settlement_days" toml:"settlement_days"`                 // Days after the reward date before shares can be sold or transferred
+	PriceRetentionDays          int  `yaml:"price_retention_days" toml:"price_retention_days"`       // Raw ticks older than this are rolled up into daily OHLC rows
+	PriceRetentionIntervalHours int  `yaml:"price_retention_interval_hours" toml:"price_retention_interval_hours"`
+	ReconcileIntervalMinutes    int  `yaml:"reconcile_interval_minutes" toml:"reconcile_interval_minutes"` // How often holdings are checked against the event log
+	ReconcileAutoRepair         bool `yaml:"reconcile_auto_repair" toml:"reconcile_auto_repair"`           // Overwrite drifted holdings instead of only reporting them
+
+	WebhookPollIntervalSeconds int `yaml:"webhook_poll_interval_seconds" toml:"webhook_poll_interval_seconds"` // How often the delivery worker polls the outbox
+	WebhookTimeoutSeconds      int `yaml:"webhook_timeout_seconds" toml:"webhook_timeout_seconds"`             // Per-request timeout for outbound webhooks
+	WebhookMaxAttempts         int `yaml:"webhook_max_attempts" toml:"webhook_max_attempts"`                   // Attempts before a delivery is dead-lettered
+	WebhookBaseBackoffSeconds  int `yaml:"webhook_base_backoff_seconds" toml:"webhook_base_backoff_seconds"`   // First retry delay; doubles per attempt
+	WebhookMaxBackoffSeconds   int `yaml:"webhook_max_backoff_seconds" toml:"webhook_max_backoff_seconds"`
 }
 
-// Load loads configuration from environment variables
-func Load() (*Config, error) {
-	// Load .env file if exists (ignore error if not found)
-	_ = godotenv.Load()
+// BudgetConfig caps reward spend in whole rupees, measured on total_cost. 0 disables a limit.
+type BudgetConfig struct {
+	MaxRewardINR   int            `yaml:"max_reward_inr" toml:"max_reward_inr"`
+	UserDailyINR   int            `yaml:"user_daily_inr" toml:"user_daily_inr"`
+	UserMonthlyINR int            `yaml:"user_monthly_inr" toml:"user_monthly_inr"`
+	GlobalDailyINR int            `yaml:"global_daily_inr" toml:"global_daily_inr"` // Serializes all rewards while enabled
+	ReasonDailyINR map[string]int `yaml:"reason_daily_inr" toml:"reason_daily_inr"` // Daily cap per reward reason
+}
+
+// ReasonDailyINRFloat returns the per-reason caps as the float amounts rewards are priced in
+func (b BudgetConfig) ReasonDailyINRFloat() map[string]float64 {
+	limits := make(map[string]float64, len(b.ReasonDailyINR))
+	for reason, limit := range b.ReasonDailyINR {
+		limits[reason] = float64(limit)
+	}
+	return limits
+}
+
+// RiskConfig configures the risk checks run on new rewards. 0 disables a limit.
+type RiskConfig struct {
+	Enabled               bool `yaml:"enabled" toml:"enabled"`
+	VelocityWindowMinutes int  `yaml:"velocity_window_minutes" toml:"velocity_window_minutes"`
+	MaxUsersPerDevice     int  `yaml:"max_users_per_device" toml:"max_users_per_device"`
+	MaxUsersPerIP         int  `yaml:"max_users_per_ip" toml:"max_users_per_ip"`
+	MaxRewardsPerUser     int  `yaml:"max_rewards_per_user" toml:"max_rewards_per_user"`
+	MaxUsersPerMetadata   int  `yaml:"max_users_per_metadata" toml:"max_users_per_metadata"` // Distinct users sharing identical metadata
+}
+
+// TracingConfig selects where OpenTelemetry spans are exported and how many are kept
+type TracingConfig struct {
+	Exporter      string `yaml:"exporter" toml:"exporter"` // none, stdout or otlp
+	ServiceName   string `yaml:"service_name" toml:"service_name"`
+	SamplePercent int    `yaml:"sample_percent" toml:"sample_percent"` // Share of new traces kept; callers' sampling decisions are honoured
+	OTLPEndpoint  string `yaml:"otlp_endpoint" toml:"otlp_endpoint"`   // Collector URL for otlp; empty uses OTEL_EXPORTER_OTLP_ENDPOINT
+}
+
+// RateLimitConfig sets per-minute token buckets for each route group
+type RateLimitConfig struct {
+	Enabled bool           `yaml:"enabled" toml:"enabled"`
+	Write   RateLimitGroup `yaml:"write" toml:"write"` // Rewards, sells, transfers and other POSTs
+	Read    RateLimitGroup `yaml:"read" toml:"read"`   // Per-user read endpoints and streams
+}
 
-	cfg := &Config{
+// RateLimitGroup limits requests per key; 0 disables that key
+type RateLimitGroup struct {
+	UserPerMinute   int `yaml:"user_per_minute" toml:"user_per_minute"`
+	IPPerMinute     int `yaml:"ip_per_minute" toml:"ip_per_minute"`
+	ClientPerMinute int `yaml:"client_per_minute" toml:"client_per_minute"`
+	Burst           int `yaml:"burst" toml:"burst"` // Requests a key may make at once before the per-minute rate applies
+}
+
+type namedRateLimitGroup struct {
+	name  string
+	group *RateLimitGroup
+}
+
+// groups lists the route groups in a fixed order, so errors are reported in a stable order
+func (c *RateLimitConfig) groups() []namedRateLimitGroup {
+	return []namedRateLimitGroup{
+		{"write", &c.Write},
+		{"read", &c.Read},
+	}
+}
+
+// Defaults returns the configuration used when neither a file nor the environment sets a value
+func Defaults() *Config {
+	return &Config{
 		Server: ServerConfig{
-			Port:    getEnv("PORT", "8080"),
-			GinMode: getEnv("GIN_MODE", "debug"),
+			Port:                   "8080",
+			GRPCPort:               "9090",
+			GinMode:                "debug",
+			ShutdownTimeoutSeconds: 30,
+			RequestTimeoutSeconds:  10,
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "stocky_user"),
-			Password: getEnv("DB_PASSWORD", "stocky_password"),
-			DBName:   getEnv("DB_NAME", "assignment"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Host:     "localhost",
+			Port:     "5432",
+			User:     "stocky_user",
+			Password: "stocky_password",
+			DBName:   "assignment",
+			SSLMode:  "disable",
+
+			MaxOpenConns:           25,
+			MaxIdleConns:           5,
+			ConnMaxLifetimeMinutes: 30,
+			ConnMaxIdleTimeMinutes: 5,
+			ConnectTimeoutSeconds:  60,
 		},
 		Fees: FeesConfig{
-			BrokerageFeeBC: getEnvAsInt("BROKERAGE_FEE_BP", 5),
-			STTFeeBC:       getEnvAsInt("STT_FEE_BP", 25),
-			GSTFeeBC:       getEnvAsInt("GST_FEE_BP", 18),
-			ExchangeFeeBC:  getEnvAsInt("EXCHANGE_FEE_BP", 3),
-			SEBIFeeBC:      getEnvAsInt("SEBI_FEE_BP", 1),
+			BrokerageFeeBP: 5,
+			STTFeeBP:       25,
+			GSTFeePercent:  18,
+			ExchangeFeeBP:  3,
+			SEBIFeeBP:      1,
 		},
 		Service: ServiceConfig{
-			PriceUpdateIntervalMinutes: getEnvAsInt("PRICE_UPDATE_INTERVAL_MINUTES", 60),
+			PriceUpdateIntervalMinutes:  60,
+			PriceMaxAgeMinutes:          180,
+			ReadinessDBLatencyMs:        500,
+			SettlementDays:              1,
+			PriceRetentionDays:          90,
+			PriceRetentionIntervalHours: 24,
+			ReconcileIntervalMinutes:    60,
+			ReconcileAutoRepair:         false,
+			WebhookPollIntervalSeconds:  5,
+			WebhookTimeoutSeconds:       10,
+			WebhookMaxAttempts:          8,
+			WebhookBaseBackoffSeconds:   30,
+			WebhookMaxBackoffSeconds:    3600,
+		},
+		Budgets: BudgetConfig{
+			MaxRewardINR:   100000,
+			UserDailyINR:   200000,
+			UserMonthlyINR: 1000000,
 		},
+		Risk: RiskConfig{
+			Enabled:               true,
+			VelocityWindowMinutes: 60,
+			MaxUsersPerDevice:     3,
+			MaxUsersPerIP:         10,
+			MaxRewardsPerUser:     20,
+			MaxUsersPerMetadata:   5,
+		},
+		Tracing: TracingConfig{
+			Exporter:      "none",
+			ServiceName:   "stocky",
+			SamplePercent: 100,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Write: RateLimitGroup{
+				UserPerMinute:   30,
+				IPPerMinute:     300,
+				ClientPerMinute: 1200,
+				Burst:           10,
+			},
+			Read: RateLimitGroup{
+				UserPerMinute:   120,
+				IPPerMinute:     1000,
+				ClientPerMinute: 6000,
+				Burst:           30,
+			},
+		},
+	}
+}
+
+// Load resolves and validates the configuration. Every problem found is
+// reported in the returned error, one per line.
+func Load() (*Config, error) {
+	cfg, err := Resolve()
+	if cfg == nil {
+		return nil, err
+	}
+
+	if err := errors.Join(err, cfg.Validate()); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
 	}
 
 	return cfg, nil
 }
 
+// Resolve builds the configuration from defaults, then the file named by
+// CONFIG_FILE (if set), then environment variables, which win. It does not
+// validate the result. Malformed environment values are reported in the error
+// alongside the configuration, which keeps the earlier value for each of them;
+// a nil configuration means the config file could not be read.
+func Resolve() (*Config, error) {
+	// Load .env file if exists (ignore error if not found)
+	_ = godotenv.Load()
+
+	cfg := Defaults()
+
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, cfg.applyEnv()
+}
+
+// loadFile overlays the values present in a config file. Unknown keys are an error,
+// so a misspelt setting fails loudly instead of silently keeping its default.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		dec := toml.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(c)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err = dec.Decode(c); err == io.EOF {
+			err = nil // empty file
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv overrides settings from environment variables, collecting every malformed value
+func (c *Config) applyEnv() error {
+	var errs []error
+	setInt := func(key string, dst *int) {
+		value, err := getEnvAsInt(key, *dst)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		*dst = value
+	}
+	setBool := func(key string, dst *bool) {
+		value, err := getEnvAsBool(key, *dst)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		*dst = value
+	}
+
+	c.Server.Port = getEnv("PORT", c.Server.Port)
+	c.Server.GinMode = getEnv("GIN_MODE", c.Server.GinMode)
+	setInt("SHUTDOWN_TIMEOUT_SECONDS", &c.Server.ShutdownTimeoutSeconds)
+	setInt("REQUEST_TIMEOUT_SECONDS", &c.Server.RequestTimeoutSeconds)
+	if port, ok := os.LookupEnv("GRPC_PORT"); ok {
+		c.Server.GRPCPort = port // Set but empty disables the gRPC server
+	}
+	if raw := os.Getenv("ADMIN_API_TOKENS"); raw != "" {
+		tokens, err := parseAdminTokens(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ADMIN_API_TOKENS: %w", err))
+		} else {
+			c.Server.AdminTokens = tokens
+		}
+	}
+
+	c.Database.Host = getEnv("DB_HOST", c.Database.Host)
+	c.Database.Port = getEnv("DB_PORT", c.Database.Port)
+	c.Database.User = getEnv("DB_USER", c.Database.User)
+	c.Database.Password = getEnv("DB_PASSWORD", c.Database.Password)
+	c.Database.DBName = getEnv("DB_NAME", c.Database.DBName)
+	c.Database.SSLMode = getEnv("DB_SSLMODE", c.Database.SSLMode)
+	setInt("DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns)
+	setInt("DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns)
+	setInt("DB_CONN_MAX_LIFETIME_MINUTES", &c.Database.ConnMaxLifetimeMinutes)
+	setInt("DB_CONN_MAX_IDLE_TIME_MINUTES", &c.Database.ConnMaxIdleTimeMinutes)
+	setInt("DB_CONNECT_TIMEOUT_SECONDS", &c.Database.ConnectTimeoutSeconds)
+	c.Database.ReplicaDSN = getEnv("DB_REPLICA_DSN", c.Database.ReplicaDSN)
+
+	setInt("BROKERAGE_FEE_BP", &c.Fees.BrokerageFeeBP)
+	setInt("STT_FEE_BP", &c.Fees.STTFeeBP)
+	if _, ok := os.LookupEnv("GST_FEE_BP"); ok {
+		// GST has always been a percentage; the old name is still honoured
+		logrus.Warn("GST_FEE_BP is deprecated, use GST_FEE_PERCENT")
+		setInt("GST_FEE_BP", &c.Fees.GSTFeePercent)
+	}
+	setInt("GST_FEE_PERCENT", &c.Fees.GSTFeePercent)
+	setInt("EXCHANGE_FEE_BP", &c.Fees.ExchangeFeeBP)
+	setInt("SEBI_FEE_BP", &c.Fees.SEBIFeeBP)
+
+	setInt("PRICE_UPDATE_INTERVAL_MINUTES", &c.Service.PriceUpdateIntervalMinutes)
+	setInt("PRICE_MAX_AGE_MINUTES", &c.Service.PriceMaxAgeMinutes)
+	setInt("READINESS_DB_LATENCY_MS", &c.Service.ReadinessDBLatencyMs)
+	setInt("SETTLEMENT_DAYS", &c.Service.SettlementDays)
+	setInt("PRICE_RETENTION_DAYS", &c.Service.PriceRetentionDays)
+	setInt("PRICE_RETENTION_INTERVAL_HOURS", &c.Service.PriceRetentionIntervalHours)
+	setInt("RECONCILE_INTERVAL_MINUTES", &c.Service.ReconcileIntervalMinutes)
+	setBool("RECONCILE_AUTO_REPAIR", &c.Service.ReconcileAutoRepair)
+	setInt("WEBHOOK_POLL_INTERVAL_SECONDS", &c.Service.WebhookPollIntervalSeconds)
+	setInt("WEBHOOK_TIMEOUT_SECONDS", &c.Service.WebhookTimeoutSeconds)
+	setInt("WEBHOOK_MAX_ATTEMPTS", &c.Service.WebhookMaxAttempts)
+	setInt("WEBHOOK_BASE_BACKOFF_SECONDS", &c.Service.WebhookBaseBackoffSeconds)
+	setInt("WEBHOOK_MAX_BACKOFF_SECONDS", &c.Service.WebhookMaxBackoffSeconds)
+
+	setInt("REWARD_MAX_INR", &c.Budgets.MaxRewardINR)
+	setInt("REWARD_BUDGET_USER_DAILY_INR", &c.Budgets.UserDailyINR)
+	setInt("REWARD_BUDGET_USER_MONTHLY_INR", &c.Budgets.UserMonthlyINR)
+	setInt("REWARD_BUDGET_GLOBAL_DAILY_INR", &c.Budgets.GlobalDailyINR)
+	if raw := os.Getenv("REWARD_BUDGET_REASON_DAILY_INR"); raw != "" {
+		limits, err := parseReasonLimits(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("REWARD_BUDGET_REASON_DAILY_INR: %w", err))
+		} else {
+			c.Budgets.ReasonDailyINR = limits
+		}
+	}
+
+	setBool("RISK_ENABLED", &c.Risk.Enabled)
+	setInt("RISK_VELOCITY_WINDOW_MINUTES", &c.Risk.VelocityWindowMinutes)
+	setInt("RISK_MAX_USERS_PER_DEVICE", &c.Risk.MaxUsersPerDevice)
+	setInt("RISK_MAX_USERS_PER_IP", &c.Risk.MaxUsersPerIP)
+	setInt("RISK_MAX_REWARDS_PER_USER", &c.Risk.MaxRewardsPerUser)
+	setInt("RISK_MAX_USERS_PER_METADATA", &c.Risk.MaxUsersPerMetadata)
+
+	c.Tracing.Exporter = getEnv("TRACING_EXPORTER", c.Tracing.Exporter)
+	c.Tracing.ServiceName = getEnv("TRACING_SERVICE_NAME", c.Tracing.ServiceName)
+	setInt("TRACING_SAMPLE_PERCENT", &c.Tracing.SamplePercent)
+	c.Tracing.OTLPEndpoint = getEnv("TRACING_OTLP_ENDPOINT", c.Tracing.OTLPEndpoint)
+
+	setBool("RATE_LIMIT_ENABLED", &c.RateLimit.Enabled)
+	for _, g := range c.RateLimit.groups() {
+		prefix, group := "RATE_LIMIT_"+strings.ToUpper(g.name), g.group
+		setInt(prefix+"_USER_PER_MINUTE", &group.UserPerMinute)
+		setInt(prefix+"_IP_PER_MINUTE", &group.IPPerMinute)
+		setInt(prefix+"_CLIENT_PER_MINUTE", &group.ClientPerMinute)
+		setInt(prefix+"_BURST", &group.Burst)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks every setting and returns all problems joined together, or nil
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.port("server.port", c.Server.Port)
+	v.oneOf("server.gin_mode", c.Server.GinMode, "debug", "release", "test")
+	v.positive("server.shutdown_timeout_seconds", c.Server.ShutdownTimeoutSeconds)
+	v.positive("server.request_timeout_seconds", c.Server.RequestTimeoutSeconds)
+	if c.Server.GRPCPort != "" {
+		v.port("server.grpc_port", c.Server.GRPCPort)
+		if c.Server.GRPCPort == c.Server.Port {
+			v.fail("server.grpc_port", "must differ from server.port (%s)", c.Server.Port)
+		}
+	}
+
+	seen := make(map[string]string, len(c.Server.AdminTokens))
+	for _, name := range sortedKeys(c.Server.AdminTokens) {
+		key, token := "server.admin_tokens."+name, c.Server.AdminTokens[name]
+		if !validAdminName(name) {
+			v.fail(key, "admin names may only use letters, digits and . _ -")
+		}
+		v.required(key, token)
+		if other, ok := seen[token]; ok && token != "" {
+			v.fail(key, "shares its token with %s", other)
+		}
+		seen[token] = name
+	}
+
+	v.required("database.host", c.Database.Host)
+	v.port("database.port", c.Database.Port)
+	v.required("database.user", c.Database.User)
+	v.required("database.name", c.Database.DBName)
+	v.oneOf("database.sslmode", c.Database.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+	v.positive("database.max_open_conns", c.Database.MaxOpenConns)
+	v.between("database.max_idle_conns", c.Database.MaxIdleConns, 0, c.Database.MaxOpenConns)
+	v.nonNegative("database.conn_max_lifetime_minutes", c.Database.ConnMaxLifetimeMinutes)
+	v.nonNegative("database.conn_max_idle_time_minutes", c.Database.ConnMaxIdleTimeMinutes)
+	v.nonNegative("database.connect_timeout_seconds", c.Database.ConnectTimeoutSeconds)
+
+	v.between("fees.brokerage_fee_bp", c.Fees.BrokerageFeeBP, 0, 10000)
+	v.between("fees.stt_fee_bp", c.Fees.STTFeeBP, 0, 10000)
+	v.between("fees.gst_fee_percent", c.Fees.GSTFeePercent, 0, 100)
+	v.between("fees.exchange_fee_bp", c.Fees.ExchangeFeeBP, 0, 10000)
+	v.between("fees.sebi_fee_bp", c.Fees.SEBIFeeBP, 0, 10000)
+
+	v.positive("service.price_update_interval_minutes", c.Service.PriceUpdateIntervalMinutes)
+	v.positive("service.price_max_age_minutes", c.Service.PriceMaxAgeMinutes)
+	v.positive("service.readiness_db_latency_ms", c.Service.ReadinessDBLatencyMs)
+	v.between("service.settlement_days", c.Service.SettlementDays, 0, 365)
+	v.positive("service.price_retention_days", c.Service.PriceRetentionDays)
+	v.positive("service.price_retention_interval_hours", c.Service.PriceRetentionIntervalHours)
+	v.positive("service.reconcile_interval_minutes", c.Service.ReconcileIntervalMinutes)
+	v.positive("service.webhook_poll_interval_seconds", c.Service.WebhookPollIntervalSeconds)
+	v.positive("service.webhook_timeout_seconds", c.Service.WebhookTimeoutSeconds)
+	v.positive("service.webhook_max_attempts", c.Service.WebhookMaxAttempts)
+	v.positive("service.webhook_base_backoff_seconds", c.Service.WebhookBaseBackoffSeconds)
+	if c.Service.WebhookMaxBackoffSeconds < c.Service.WebhookBaseBackoffSeconds {
+		v.fail("service.webhook_max_backoff_seconds", "must be at least webhook_base_backoff_seconds (%d), got %d",
+			c.Service.WebhookBaseBackoffSeconds, c.Service.WebhookMaxBackoffSeconds)
+	}
+
+	v.nonNegative("budgets.max_reward_inr", c.Budgets.MaxRewardINR)
+	v.nonNegative("budgets.user_daily_inr", c.Budgets.UserDailyINR)
+	v.nonNegative("budgets.user_monthly_inr", c.Budgets.UserMonthlyINR)
+	v.nonNegative("budgets.global_daily_inr", c.Budgets.GlobalDailyINR)
+	for _, reason := range sortedKeys(c.Budgets.ReasonDailyINR) {
+		v.nonNegative("budgets.reason_daily_inr."+reason, c.Budgets.ReasonDailyINR[reason])
+	}
+
+	if c.Risk.Enabled {
+		v.positive("risk.velocity_window_minutes", c.Risk.VelocityWindowMinutes)
+	}
+	v.nonNegative("risk.max_users_per_device", c.Risk.MaxUsersPerDevice)
+	v.nonNegative("risk.max_users_per_ip", c.Risk.MaxUsersPerIP)
+	v.nonNegative("risk.max_rewards_per_user", c.Risk.MaxRewardsPerUser)
+	v.nonNegative("risk.max_users_per_metadata", c.Risk.MaxUsersPerMetadata)
+
+	v.oneOf("tracing.exporter", c.Tracing.Exporter, "none", "stdout", "otlp")
+	v.required("tracing.service_name", c.Tracing.ServiceName)
+	v.between("tracing.sample_percent", c.Tracing.SamplePercent, 0, 100)
+
+	for _, g := range c.RateLimit.groups() {
+		key, group := "rate_limit."+g.name, g.group
+		v.nonNegative(key+".user_per_minute", group.UserPerMinute)
+		v.nonNegative(key+".ip_per_minute", group.IPPerMinute)
+		v.nonNegative(key+".client_per_minute", group.ClientPerMinute)
+		v.positive(key+".burst", group.Burst)
+	}
+
+	return errors.Join(v.errs...)
+}
+
+// Redacted returns a copy that is safe to print or log
+func (c *Config) Redacted() *Config {
+	out := *c
+	if out.Database.Password != "" {
+		out.Database.Password = redacted
+	}
+	if out.Server.AdminTokens != nil {
+		out.Server.AdminTokens = make(map[string]string, len(c.Server.AdminTokens))
+		for name := range c.Server.AdminTokens {
+			out.Server.AdminTokens[name] = redacted
+		}
+	}
+	out.Database.ReplicaDSN = redactDSN(out.Database.ReplicaDSN)
+	out.Tracing.OTLPEndpoint = redactDSN(out.Tracing.OTLPEndpoint)
+	return &out
+}
+
+// dsnPassword matches the password of a key=value DSN, quoted or not
+var dsnPassword = regexp.MustCompile(`password=('(?:[^'\\]|\\.)*'|\S+)`)
+
+// redactDSN masks the password in a URL or key=value connection string
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" {
+		if q := u.Query(); q.Has("password") {
+			q.Set("password", "xxxxx") // matches what Redacted puts in the userinfo
+			u.RawQuery = q.Encode()
+		}
+		return u.Redacted()
+	}
+	return dsnPassword.ReplaceAllString(dsn, "password="+redacted)
+}
+
 // GetDSN returns PostgreSQL connection string
 func (c *DatabaseConfig) GetDSN() string {
 	return fmt.Sprintf(
@@ -83,6 +536,55 @@ func (c *DatabaseConfig) GetDSN() string {
 	)
 }
 
+// validator collects validation failures, one per setting
+type validator struct {
+	errs []error
+}
+
+func (v *validator) fail(key, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Errorf("%s: "+format, append([]interface{}{key}, args...)...))
+}
+
+func (v *validator) required(key, value string) {
+	if value == "" {
+		v.fail(key, "is required")
+	}
+}
+
+func (v *validator) positive(key string, value int) {
+	if value <= 0 {
+		v.fail(key, "must be positive, got %d", value)
+	}
+}
+
+func (v *validator) nonNegative(key string, value int) {
+	if value < 0 {
+		v.fail(key, "must not be negative, got %d", value)
+	}
+}
+
+func (v *validator) between(key string, value, min, max int) {
+	if value < min || value > max {
+		v.fail(key, "must be between %d and %d, got %d", min, max, value)
+	}
+}
+
+func (v *validator) port(key, value string) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		v.fail(key, "must be a port number between 1 and 65535, got %q", value)
+	}
+}
+
+func (v *validator) oneOf(key, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.fail(key, "must be one of %v, got %q", allowed, value)
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -90,15 +592,83 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
-func getEnvAsInt(key string, defaultValue int) int {
+// getEnvAsInt returns defaultValue when key is unset, and an error when it is set but not an integer
+func getEnvAsInt(key string, defaultValue int) (int, error) {
 	valueStr := os.Getenv(key)
 	if valueStr == "" {
-		return defaultValue
+		return defaultValue, nil
 	}
 	value, err := strconv.Atoi(valueStr)
 	if err != nil {
-		logrus.Warnf("Invalid integer for %s, using default %d", key, defaultValue)
-		return defaultValue
+		return defaultValue, fmt.Errorf("%s: invalid integer %q", key, valueStr)
+	}
+	return value, nil
+}
+
+// parseReasonLimits parses "referral=5000,signup=1000"
+func parseReasonLimits(raw string) (map[string]int, error) {
+	limits := make(map[string]int)
+	for _, pair := range strings.Split(raw, ",") {
+		reason, amount, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || strings.TrimSpace(reason) == "" {
+			return nil, fmt.Errorf("want reason=amount pairs, got %q", pair)
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(amount))
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for %s: %q", reason, amount)
+		}
+		limits[strings.TrimSpace(reason)] = value
+	}
+	return limits, nil
+}
+
+// parseAdminTokens reads "name=token,..." pairs
+func parseAdminTokens(raw string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		name, token, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("want name=token pairs, got %q", pair)
+		}
+		tokens[strings.TrimSpace(name)] = strings.TrimSpace(token)
+	}
+	return tokens, nil
+}
+
+// validAdminName keeps admin names, which end up in the audit log, to short plain identifiers
+func validAdminName(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getEnvAsBool returns defaultValue when key is unset, and an error when it is set but not a boolean
+func getEnvAsBool(key string, defaultValue bool) (bool, error) {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return defaultValue, fmt.Errorf("%s: invalid boolean %q", key, valueStr)
 	}
-	return value
+	return value, nil
 }
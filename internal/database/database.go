@@ -1,40 +1,132 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
 	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/config"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
-// Connect establishes a database connection
-func Connect(dsn string, log *logrus.Logger) (*sql.DB, error) {
-	db, err := sql.Open("postgres", dsn)
+// Startup retry timing
+const (
+	initialConnectBackoff = 500 * time.Millisecond
+	maxConnectBackoff     = 10 * time.Second
+	connectAttemptTimeout = 5 * time.Second
+)
+
+// PoolConfig sizes a connection pool and bounds how long Connect waits for the database
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration // Zero keeps connections forever
+	ConnMaxIdleTime time.Duration // Zero keeps idle connections forever
+	ConnectTimeout  time.Duration // Zero pings once
+}
+
+// PoolFromConfig converts the database settings into a PoolConfig
+func PoolFromConfig(cfg config.DatabaseConfig) PoolConfig {
+	return PoolConfig{
+		MaxOpenConns:    cfg.MaxOpenConns,
+		MaxIdleConns:    cfg.MaxIdleConns,
+		ConnMaxLifetime: time.Duration(cfg.ConnMaxLifetimeMinutes) * time.Minute,
+		ConnMaxIdleTime: time.Duration(cfg.ConnMaxIdleTimeMinutes) * time.Minute,
+		ConnectTimeout:  time.Duration(cfg.ConnectTimeoutSeconds) * time.Second,
+	}
+}
+
+// Connect opens a connection pool and pings it, retrying with exponential
+// backoff until the database answers or ConnectTimeout passes, so the service
+// can start alongside a database that is still booting.
+func Connect(ctx context.Context, dsn string, pool PoolConfig, log *logrus.Logger) (*sql.DB, error) {
+	db, err := Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	// Test connection
-	if err := db.Ping(); err != nil {
+	// Set connection pool settings
+	db.SetMaxOpenConns(pool.MaxOpenConns)
+	db.SetMaxIdleConns(pool.MaxIdleConns)
+	db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
+
+	if err := pingWithRetry(ctx, db, pool.ConnectTimeout, log); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	// Set connection pool settings
-	db.SetMaxOpenConns(25)
-	db.SetMaxIdleConns(5)
-
-	log.Info("Database connection established")
+	log.WithFields(logrus.Fields{
+		"max_open_conns": pool.MaxOpenConns,
+		"max_idle_conns": pool.MaxIdleConns,
+	}).Info("Database connection established")
 	return db, nil
 }
 
-// RunMigrations executes SQL migration files
-func RunMigrations(db *sql.DB, migrationsPath string, log *logrus.Logger) error {
-	files, err := filepath.Glob(filepath.Join(migrationsPath, "*.sql"))
+// Open opens a pool on the named driver without connecting. Every query gets a
+// span under the caller's span; with tracing off they cost next to nothing.
+func Open(driverName, dsn string) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			OmitRows:             true,
+		}),
+	)
+}
+
+// pingWithRetry pings until it succeeds or timeout passes; a zero timeout pings once
+func pingWithRetry(ctx context.Context, db *sql.DB, timeout time.Duration, log *logrus.Logger) error {
+	deadline := time.Now().Add(timeout)
+	backoff := initialConnectBackoff
+
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, connectAttemptTimeout)
+		err := db.PingContext(pingCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("gave up after %d attempts: %w", attempt, err)
+		}
+		if backoff > remaining {
+			backoff = remaining
+		}
+
+		log.WithError(err).Warnf("Database not reachable (attempt %d), retrying in %s", attempt, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+}
+
+// RunMigrations executes SQL migration files that have not been applied yet.
+// Applied versions are recorded in schema_migrations so each file runs once.
+func RunMigrations(ctx context.Context, db *sql.DB, migrationsPath string, log *logrus.Logger) error {
+	files, err := migrationFiles(migrationsPath)
 	if err != nil {
-		return fmt.Errorf("failed to find migration files: %w", err)
+		return err
 	}
 
 	if len(files) == 0 {
@@ -42,19 +134,101 @@ func RunMigrations(db *sql.DB, migrationsPath string, log *logrus.Logger) error
 		return nil
 	}
 
-	for _, file := range files {
-		log.Infof("Running migration: %s", filepath.Base(file))
-		
-		content, err := ioutil.ReadFile(file)
+	createTable := `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+		)
+	`
+	if _, err := db.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	for _, m := range files {
+		var applied bool
+		err := db.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`, m.version,
+		).Scan(&applied)
 		if err != nil {
-			return fmt.Errorf("failed to read migration file %s: %w", file, err)
+			return fmt.Errorf("failed to check migration %s: %w", m.name, err)
+		}
+		if applied {
+			continue
 		}
 
-		if _, err := db.Exec(string(content)); err != nil {
-			return fmt.Errorf("failed to execute migration %s: %w", file, err)
+		log.Infof("Running migration: %s", m.name)
+
+		content, err := os.ReadFile(m.path)
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %s: %w", m.path, err)
+		}
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %s: %w", m.name, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, string(content)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to execute migration %s: %w", m.name, err)
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.version, m.name,
+		); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %s: %w", m.name, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %s: %w", m.name, err)
 		}
 	}
 
 	log.Info("All migrations completed successfully")
 	return nil
 }
+
+// LatestMigrationVersion returns the highest migration version found on disk
+func LatestMigrationVersion(migrationsPath string) (int, error) {
+	files, err := migrationFiles(migrationsPath)
+	if err != nil {
+		return 0, err
+	}
+	if len(files) == 0 {
+		return 0, nil
+	}
+	return files[len(files)-1].version, nil
+}
+
+type migrationFile struct {
+	version int
+	name    string
+	path    string
+}
+
+// migrationFiles lists NNN_name.sql files sorted by their numeric version
+func migrationFiles(migrationsPath string) ([]migrationFile, error) {
+	paths, err := filepath.Glob(filepath.Join(migrationsPath, "*.sql"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find migration files: %w", err)
+	}
+
+	files := make([]migrationFile, 0, len(paths))
+	for _, path := range paths {
+		name := filepath.Base(path)
+		prefix, _, found := strings.Cut(name, "_")
+		if !found {
+			return nil, fmt.Errorf("migration file %s must be named NNN_description.sql", name)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration file %s has invalid version prefix: %w", name, err)
+		}
+		files = append(files, migrationFile{version: version, name: name, path: path})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].version < files[j].version })
+	return files, nil
+}
@@ -0,0 +1,162 @@
+package grpcserver
+
+import (
+	"database/sql"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/services"
+	stockyv1 "github.com/stocky/assignment/pkg/pb/stocky/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Conversions between the protobuf messages and the service and model types
+
+func rewardRequestFromProto(req *stockyv1.CreateRewardRequest) *services.RewardRequest {
+	rewardReq := &services.RewardRequest{
+		IdempotencyKey: req.GetIdempotencyKey(),
+		UserID:         req.GetUserId(),
+		StockSymbol:    req.GetStockSymbol(),
+		ISIN:           req.GetIsin(),
+		SharesQuantity: req.GetSharesQuantity(),
+		Reason:         req.GetReason(),
+		Metadata:       req.GetMetadata(),
+		DeviceID:       req.GetDeviceId(),
+		IPAddress:      req.GetIpAddress(),
+	}
+	if req.GetRewardedAt() != nil {
+		rewardReq.RewardedAt = req.GetRewardedAt().AsTime()
+	}
+
+	if v := req.GetVesting(); v != nil {
+		vesting := &services.VestingRequest{
+			Type:         v.GetType(),
+			CliffDays:    int(v.GetCliffDays()),
+			DurationDays: int(v.GetDurationDays()),
+		}
+		for _, t := range v.GetTranches() {
+			vesting.Tranches = append(vesting.Tranches, services.VestingTrancheRequest{
+				AfterDays: int(t.GetAfterDays()),
+				Percent:   t.GetPercent(),
+			})
+		}
+		rewardReq.Vesting = vesting
+	}
+	return rewardReq
+}
+
+func rewardEventToProto(event *models.RewardEvent) *stockyv1.RewardEvent {
+	return &stockyv1.RewardEvent{
+		Id:             event.ID,
+		IdempotencyKey: event.IdempotencyKey,
+		UserId:         event.UserID,
+		StockSymbol:    event.StockSymbol,
+		SharesQuantity: event.SharesQuantity,
+		PricePerShare:  event.PricePerShare,
+		TotalValue:     event.TotalValue,
+		BrokerageFee:   event.BrokerageFee,
+		SttFee:         event.STTFee,
+		GstFee:         event.GSTFee,
+		ExchangeFee:    event.ExchangeFee,
+		SebiFee:        event.SEBIFee,
+		StampDutyFee:   event.StampDutyFee,
+		TotalFees:      event.TotalFees,
+		TotalCost:      event.TotalCost,
+		Reason:         event.Reason,
+		Metadata:       event.Metadata.String,
+		RewardedAt:     timestamppb.New(event.RewardedAt),
+		RiskDecision:   event.RiskDecision,
+		RiskSignals:    riskSignalsToProto(event.RiskSignals),
+		CreatedAt:      timestamppb.New(event.CreatedAt),
+		Vesting:        vestingToProto(event.Vesting),
+		Isin:           event.ISIN,
+		Exchange:       event.Exchange,
+	}
+}
+
+func vestingToProto(schedule *models.VestingSchedule) *stockyv1.VestingSchedule {
+	if schedule == nil {
+		return nil
+	}
+	tranches := make([]*stockyv1.VestingTranche, 0, len(schedule.Tranches))
+	for _, t := range schedule.Tranches {
+		tranches = append(tranches, &stockyv1.VestingTranche{
+			VestAt:   timestamppb.New(t.VestAt),
+			Quantity: t.Quantity,
+		})
+	}
+	return &stockyv1.VestingSchedule{
+		ScheduleType:  schedule.ScheduleType,
+		TotalQuantity: schedule.TotalQuantity,
+		StartAt:       timestamppb.New(schedule.StartAt),
+		CliffAt:       nullTimeToProto(schedule.CliffAt),
+		EndAt:         nullTimeToProto(schedule.EndAt),
+		Tranches:      tranches,
+		Status:        schedule.Status,
+	}
+}
+
+func rewardReviewToProto(review *models.RewardReview) *stockyv1.RewardReview {
+	return &stockyv1.RewardReview{
+		Id:             review.ID,
+		IdempotencyKey: review.IdempotencyKey,
+		UserId:         review.UserID,
+		StockSymbol:    review.StockSymbol,
+		Decision:       review.Decision,
+		Signals:        riskSignalsToProto(review.Signals),
+		Status:         review.Status,
+		CreatedAt:      timestamppb.New(review.CreatedAt),
+	}
+}
+
+func riskSignalsToProto(signals []models.RiskSignal) []*stockyv1.RiskSignal {
+	out := make([]*stockyv1.RiskSignal, 0, len(signals))
+	for _, s := range signals {
+		out = append(out, &stockyv1.RiskSignal{
+			Check:    s.Check,
+			Decision: s.Decision,
+			Detail:   s.Detail,
+		})
+	}
+	return out
+}
+
+func holdingToProto(item models.PortfolioItem) *stockyv1.Holding {
+	return &stockyv1.Holding{
+		StockSymbol:    item.StockSymbol,
+		CompanyName:    item.CompanyName,
+		TotalShares:    item.TotalShares,
+		AveragePrice:   item.AveragePrice,
+		CurrentPrice:   item.CurrentPrice,
+		CurrentValue:   item.CurrentValue,
+		TotalCost:      item.TotalCost,
+		ProfitLoss:     item.ProfitLoss,
+		ProfitLossPct:  item.ProfitLossPct,
+		VestedShares:   item.VestedShares,
+		UnvestedShares: item.UnvestedShares,
+		Exchange:       item.Exchange,
+	}
+}
+
+func statsToProto(stats *services.UserStatsResponse) *stockyv1.GetStatsResponse {
+	today := make([]*stockyv1.StockRewardSummary, 0, len(stats.TodayRewards))
+	for _, r := range stats.TodayRewards {
+		today = append(today, &stockyv1.StockRewardSummary{
+			StockSymbol: r.StockSymbol,
+			TotalShares: r.TotalShares,
+			RewardCount: int32(r.RewardCount),
+		})
+	}
+	return &stockyv1.GetStatsResponse{
+		UserId:              stats.UserID,
+		TodayRewards:        today,
+		PortfolioValueInr:   stats.PortfolioValueINR,
+		TotalSharesRewarded: stats.TotalSharesRewarded,
+	}
+}
+
+func nullTimeToProto(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
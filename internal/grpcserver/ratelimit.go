@@ -0,0 +1,133 @@
+package grpcserver
+
+import (
+	"context"
+	"math"
+	"net"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/ratelimit"
+	"github.com/stocky/assignment/internal/requestctx"
+	stockyv1 "github.com/stocky/assignment/pkg/pb/stocky/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// MetadataClientID identifies the calling service for rate limiting, like the
+// REST API's X-Client-ID header
+const MetadataClientID = "x-client-id"
+
+// RateLimits applies the REST API's rate limits to RewardService calls. Calls
+// take tokens from the same buckets as REST requests, so a caller can't get
+// around a limit by switching API.
+type RateLimits struct {
+	Limiter ratelimit.Limiter
+	Write   ratelimit.Policy // CreateReward and BatchCreateRewards
+	Read    ratelimit.Policy // GetPortfolio and GetStats
+}
+
+// policy returns the policy limiting a method; health and reflection calls, and
+// every call when limits is nil, are not limited
+func (l *RateLimits) policy(fullMethod string) (ratelimit.Policy, bool) {
+	if l == nil {
+		return ratelimit.Policy{}, false
+	}
+	switch fullMethod {
+	case stockyv1.RewardService_CreateReward_FullMethodName, stockyv1.RewardService_BatchCreateRewards_FullMethodName:
+		return l.Write, true
+	case stockyv1.RewardService_GetPortfolio_FullMethodName, stockyv1.RewardService_GetStats_FullMethodName:
+		return l.Read, true
+	}
+	return ratelimit.Policy{}, false
+}
+
+// allow takes a token from the policy's buckets for client, user and ip. It returns
+// a ResourceExhausted status with RetryInfo when a bucket is empty, and nil when
+// the call may go ahead. If the limiter fails, calls are let through.
+func (l *RateLimits) allow(ctx context.Context, policy ratelimit.Policy, client, user, ip string, log *logrus.Logger) *status.Status {
+	if l == nil || l.Limiter == nil {
+		return nil
+	}
+	names, buckets := policy.Buckets(client, user, ip)
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	result, err := l.Limiter.Allow(ctx, buckets...)
+	if err != nil {
+		requestctx.Logger(ctx, log).WithError(err).WithField("group", policy.Group).Warn("Rate limiter unavailable, allowing call")
+		return nil
+	}
+	if result.Allowed {
+		return nil
+	}
+
+	name := names[result.Rejected]
+	metrics.GRPCRateLimited.WithLabelValues(policy.Group, name).Inc()
+	retryIn := time.Duration(math.Ceil(result.RetryAfter.Seconds())) * time.Second
+	st := status.Newf(codes.ResourceExhausted, "rate limit exceeded: too many requests per %s, retry in %s", name, retryIn)
+	detailed, err := st.WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(result.RetryAfter)},
+		&errdetails.ErrorInfo{
+			Reason:   "RATE_LIMITED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"group": policy.Group, "key": name},
+		},
+	)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// allowBatchReward takes a token from the user's write bucket for one reward of a
+// batch; the call already paid the client and IP buckets
+func (l *RateLimits) allowBatchReward(ctx context.Context, user string, log *logrus.Logger) *status.Status {
+	if l == nil {
+		return nil
+	}
+	return l.allow(ctx, l.Write, "", user, "", log)
+}
+
+// rateLimitInterceptor rejects calls over the limits with ResourceExhausted. The
+// client comes from x-client-id metadata, the user from the request's user_id and
+// the IP from the peer. A batch takes one client and IP token for the call; each
+// of its rewards then takes a token for its user, as a POST /reward would.
+func rateLimitInterceptor(limits *RateLimits, log *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		policy, ok := limits.policy(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		var user string
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			user = r.GetUserId()
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if st := limits.allow(ctx, policy, firstValue(md, MetadataClientID), user, peerIP(ctx), log); st != nil {
+			return nil, st.Err()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// peerIP returns the caller's IP address, or "" when it is unknown
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,188 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/ratelimit"
+	"github.com/stocky/assignment/internal/services"
+	stockyv1 "github.com/stocky/assignment/pkg/pb/stocky/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeRewardService issues every reward it is asked for
+type fakeRewardService struct {
+	services.RewardService
+}
+
+func (fakeRewardService) CreateReward(ctx context.Context, req *services.RewardRequest) (*models.RewardEvent, error) {
+	return &models.RewardEvent{UserID: req.UserID, StockSymbol: req.StockSymbol, SharesQuantity: req.SharesQuantity}, nil
+}
+
+// dialTestServer serves RewardService over an in-memory listener
+func dialTestServer(t *testing.T, limits *RateLimits) *grpc.ClientConn {
+	t.Helper()
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := NewServer(fakeRewardService{}, time.Second, limits, log)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func rewardRequest(key, userID string) *stockyv1.CreateRewardRequest {
+	return &stockyv1.CreateRewardRequest{IdempotencyKey: key, UserId: userID, StockSymbol: "TCS", SharesQuantity: 1}
+}
+
+func TestRateLimitInterceptorReturnsRetryInfo(t *testing.T) {
+	conn := dialTestServer(t, &RateLimits{
+		Limiter: ratelimit.NewMemoryLimiter(),
+		Write:   ratelimit.Policy{Group: "write", User: ratelimit.Limit{PerMinute: 30, Burst: 1}},
+	})
+	client := stockyv1.NewRewardServiceClient(conn)
+	ctx := context.Background()
+
+	if _, err := client.CreateReward(ctx, rewardRequest("a-1", "ravi")); err != nil {
+		t.Fatalf("first reward: %v", err)
+	}
+
+	_, err := client.CreateReward(ctx, rewardRequest("a-2", "ravi"))
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("second reward: code %s, want ResourceExhausted", st.Code())
+	}
+	var retry *errdetails.RetryInfo
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.RetryInfo:
+			retry = d
+		case *errdetails.ErrorInfo:
+			info = d
+		}
+	}
+	if retry == nil {
+		t.Fatal("no RetryInfo in the status")
+	}
+	// 30 per minute refills a token every 2s
+	if delay := retry.GetRetryDelay().AsDuration(); delay <= 0 || delay > 2*time.Second {
+		t.Errorf("retry delay = %s, want (0, 2s]", delay)
+	}
+	if info.GetReason() != "RATE_LIMITED" || info.GetMetadata()["key"] != "user" || info.GetMetadata()["group"] != "write" {
+		t.Errorf("ErrorInfo = %+v", info)
+	}
+
+	// Another user has its own bucket
+	if _, err := client.CreateReward(ctx, rewardRequest("a-3", "ankit")); err != nil {
+		t.Errorf("other user: %v", err)
+	}
+}
+
+func TestRateLimitInterceptorSharesRESTBuckets(t *testing.T) {
+	limiter := ratelimit.NewMemoryLimiter()
+	write := ratelimit.Policy{Group: "write", Client: ratelimit.Limit{PerMinute: 60, Burst: 1}}
+	conn := dialTestServer(t, &RateLimits{Limiter: limiter, Write: write})
+
+	// A REST request from the same client spends the only token
+	_, buckets := write.Buckets("payouts", "", "")
+	if result, err := limiter.Allow(context.Background(), buckets...); err != nil || !result.Allowed {
+		t.Fatalf("REST request: %+v, %v", result, err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), MetadataClientID, "payouts")
+	_, err := stockyv1.NewRewardServiceClient(conn).CreateReward(ctx, rewardRequest("b-1", "ravi"))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("gRPC call after the REST request: %v, want ResourceExhausted", err)
+	}
+}
+
+func TestBatchRewardsAreLimitedPerUser(t *testing.T) {
+	conn := dialTestServer(t, &RateLimits{
+		Limiter: ratelimit.NewMemoryLimiter(),
+		Write:   ratelimit.Policy{Group: "write", User: ratelimit.Limit{PerMinute: 60, Burst: 2}},
+	})
+
+	resp, err := stockyv1.NewRewardServiceClient(conn).BatchCreateRewards(context.Background(), &stockyv1.BatchCreateRewardsRequest{
+		Rewards: []*stockyv1.CreateRewardRequest{
+			rewardRequest("c-1", "ravi"),
+			rewardRequest("c-2", "ravi"),
+			rewardRequest("c-3", "ravi"),
+			rewardRequest("c-4", "ankit"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchCreateRewards: %v", err)
+	}
+
+	want := []stockyv1.RewardStatus{
+		stockyv1.RewardStatus_REWARD_STATUS_ISSUED,
+		stockyv1.RewardStatus_REWARD_STATUS_ISSUED,
+		stockyv1.RewardStatus_REWARD_STATUS_FAILED,
+		stockyv1.RewardStatus_REWARD_STATUS_ISSUED,
+	}
+	for i, result := range resp.GetResults() {
+		if result.GetStatus() != want[i] {
+			t.Errorf("reward %d: status %s, want %s (%s)", i, result.GetStatus(), want[i], result.GetErrorMessage())
+		}
+	}
+	if code := codes.Code(resp.GetResults()[2].GetErrorCode()); code != codes.ResourceExhausted {
+		t.Errorf("third reward for ravi: error code %s, want ResourceExhausted", code)
+	}
+}
+
+func TestRateLimitInterceptorSkipsHealthChecks(t *testing.T) {
+	conn := dialTestServer(t, &RateLimits{
+		Limiter: ratelimit.NewMemoryLimiter(),
+		Read:    ratelimit.Policy{Group: "read", IP: ratelimit.Limit{PerMinute: 1, Burst: 1}},
+	})
+
+	health := healthpb.NewHealthClient(conn)
+	for i := 0; i < 3; i++ {
+		if _, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+			t.Fatalf("health check %d: %v", i+1, err)
+		}
+	}
+}
+
+func TestNilRateLimitsAllowEveryCall(t *testing.T) {
+	conn := dialTestServer(t, nil)
+	client := stockyv1.NewRewardServiceClient(conn)
+
+	for i, key := range []string{"d-1", "d-2", "d-3"} {
+		if _, err := client.CreateReward(context.Background(), rewardRequest(key, "ravi")); err != nil {
+			t.Fatalf("reward %d with rate limiting off: %v", i+1, err)
+		}
+	}
+	resp, err := client.BatchCreateRewards(context.Background(), &stockyv1.BatchCreateRewardsRequest{
+		Rewards: []*stockyv1.CreateRewardRequest{rewardRequest("d-4", "ravi")},
+	})
+	if err != nil {
+		t.Fatalf("BatchCreateRewards with rate limiting off: %v", err)
+	}
+	if got := resp.GetResults()[0].GetStatus(); got != stockyv1.RewardStatus_REWARD_STATUS_ISSUED {
+		t.Errorf("batch reward: status %s, want ISSUED (%s)", got, resp.GetResults()[0].GetErrorMessage())
+	}
+}
@@ -0,0 +1,234 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/services"
+	stockyv1 "github.com/stocky/assignment/pkg/pb/stocky/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchRewards caps BatchCreateRewards, so one call can't hold a connection for long
+const maxBatchRewards = 500
+
+// errorDomain is the ErrorInfo domain of RewardService errors
+const errorDomain = "stocky"
+
+type rewardServer struct {
+	stockyv1.UnimplementedRewardServiceServer
+	rewardService  services.RewardService
+	requestTimeout time.Duration
+	limits         *RateLimits
+	log            *logrus.Logger
+}
+
+// NewRewardServer returns the RewardService implementation. requestTimeout bounds
+// each reward of a batch; zero leaves them unbounded. limits rate limits each
+// reward of a batch per user; nil turns that off.
+func NewRewardServer(rewardService services.RewardService, requestTimeout time.Duration, limits *RateLimits, log *logrus.Logger) stockyv1.RewardServiceServer {
+	return &rewardServer{
+		rewardService:  rewardService,
+		requestTimeout: requestTimeout,
+		limits:         limits,
+		log:            log,
+	}
+}
+
+func (s *rewardServer) CreateReward(ctx context.Context, req *stockyv1.CreateRewardRequest) (*stockyv1.CreateRewardResponse, error) {
+	resp, err := s.createReward(ctx, req)
+	if err != nil {
+		return nil, rewardStatus(err).Err()
+	}
+	return resp, nil
+}
+
+func (s *rewardServer) BatchCreateRewards(ctx context.Context, req *stockyv1.BatchCreateRewardsRequest) (*stockyv1.BatchCreateRewardsResponse, error) {
+	if len(req.GetRewards()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rewards is required")
+	}
+	if len(req.GetRewards()) > maxBatchRewards {
+		return nil, status.Errorf(codes.InvalidArgument, "a batch holds at most %d rewards, got %d", maxBatchRewards, len(req.GetRewards()))
+	}
+
+	results := make([]*stockyv1.BatchRewardResult, len(req.GetRewards()))
+	for i, item := range req.GetRewards() {
+		results[i] = s.batchReward(ctx, item)
+	}
+	return &stockyv1.BatchCreateRewardsResponse{Results: results}, nil
+}
+
+// batchReward creates one reward of a batch under its own deadline and reports a
+// failure in the result instead of failing the batch. The reward takes a token
+// from its user's bucket; the call already paid the client and IP buckets.
+func (s *rewardServer) batchReward(ctx context.Context, req *stockyv1.CreateRewardRequest) *stockyv1.BatchRewardResult {
+	if st := s.limits.allowBatchReward(ctx, req.GetUserId(), s.log); st != nil {
+		return &stockyv1.BatchRewardResult{
+			Status:       stockyv1.RewardStatus_REWARD_STATUS_FAILED,
+			ErrorCode:    int32(st.Code()),
+			ErrorMessage: st.Message(),
+		}
+	}
+
+	if s.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.requestTimeout)
+		defer cancel()
+	}
+
+	resp, err := s.createReward(ctx, req)
+	if err != nil {
+		st := rewardStatus(err)
+		return &stockyv1.BatchRewardResult{
+			Status:       stockyv1.RewardStatus_REWARD_STATUS_FAILED,
+			ErrorCode:    int32(st.Code()),
+			ErrorMessage: st.Message(),
+		}
+	}
+	return &stockyv1.BatchRewardResult{
+		Status: resp.Status,
+		Reward: resp.Reward,
+		Review: resp.Review,
+	}
+}
+
+// createReward validates the request like POST /reward and creates the reward.
+// A held reward is a successful outcome, as it is over REST.
+func (s *rewardServer) createReward(ctx context.Context, req *stockyv1.CreateRewardRequest) (*stockyv1.CreateRewardResponse, error) {
+	rewardReq := rewardRequestFromProto(req)
+	if err := binding.Validator.ValidateStruct(rewardReq); err != nil {
+		requestctx.Logger(ctx, s.log).Errorf("Invalid reward request: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	// If rewarded_at is not provided, set to current time
+	if rewardReq.RewardedAt.IsZero() {
+		rewardReq.RewardedAt = time.Now()
+	}
+
+	event, err := s.rewardService.CreateReward(ctx, rewardReq)
+	var heldErr *services.RewardHeldError
+	if errors.As(err, &heldErr) {
+		return &stockyv1.CreateRewardResponse{
+			Status: stockyv1.RewardStatus_REWARD_STATUS_HELD,
+			Review: rewardReviewToProto(heldErr.Review),
+		}, nil
+	}
+	if err != nil {
+		requestctx.Logger(ctx, s.log).Errorf("Failed to create reward: %v", err)
+		return nil, err
+	}
+
+	return &stockyv1.CreateRewardResponse{
+		Status: stockyv1.RewardStatus_REWARD_STATUS_ISSUED,
+		Reward: rewardEventToProto(event),
+	}, nil
+}
+
+func (s *rewardServer) GetPortfolio(ctx context.Context, req *stockyv1.GetPortfolioRequest) (*stockyv1.GetPortfolioResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	portfolio, err := s.rewardService.GetUserPortfolio(ctx, req.GetUserId())
+	if err != nil {
+		requestctx.Logger(ctx, s.log).Errorf("Failed to get portfolio: %v", err)
+		return nil, serviceStatus("failed to fetch portfolio", err).Err()
+	}
+
+	// Calculate total portfolio value
+	totalValue := 0.0
+	totalCost := 0.0
+	holdings := make([]*stockyv1.Holding, 0, len(portfolio))
+	for _, item := range portfolio {
+		totalValue += item.CurrentValue
+		totalCost += item.TotalCost
+		holdings = append(holdings, holdingToProto(item))
+	}
+
+	return &stockyv1.GetPortfolioResponse{
+		UserId: req.GetUserId(),
+		Summary: &stockyv1.PortfolioSummary{
+			TotalValue:      totalValue,
+			TotalCost:       totalCost,
+			TotalProfitLoss: totalValue - totalCost,
+			HoldingsCount:   int32(len(portfolio)),
+		},
+		Holdings: holdings,
+	}, nil
+}
+
+func (s *rewardServer) GetStats(ctx context.Context, req *stockyv1.GetStatsRequest) (*stockyv1.GetStatsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	stats, err := s.rewardService.GetUserStats(ctx, req.GetUserId())
+	if err != nil {
+		requestctx.Logger(ctx, s.log).Errorf("Failed to get stats: %v", err)
+		return nil, serviceStatus("failed to fetch stats", err).Err()
+	}
+	return statsToProto(stats), nil
+}
+
+// rewardStatus maps a CreateReward error to the gRPC equivalent of its REST status
+func rewardStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	var budgetErr *services.BudgetExceededError
+	var deniedErr *services.RewardDeniedError
+	switch {
+	case errors.As(err, &deniedErr):
+		return withErrorInfo(status.New(codes.PermissionDenied, err.Error()), &errdetails.ErrorInfo{
+			Reason:   "REWARD_DENIED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"review_id": fmt.Sprint(deniedErr.Review.ID)},
+		})
+	case errors.As(err, &budgetErr):
+		b := budgetErr.Breach
+		return withErrorInfo(status.New(codes.FailedPrecondition, err.Error()), &errdetails.ErrorInfo{
+			Reason: "BUDGET_EXCEEDED",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"limit":         b.Limit,
+				"reason":        b.Reason,
+				"limit_inr":     fmt.Sprintf("%.2f", b.LimitINR),
+				"spent_inr":     fmt.Sprintf("%.2f", b.SpentINR),
+				"requested_inr": fmt.Sprintf("%.2f", b.RequestedINR),
+			},
+		})
+	case errors.Is(err, services.ErrInvalidVesting), errors.Is(err, services.ErrInvalidBudgetOverride),
+		errors.Is(err, services.ErrInvalidInstrument), errors.Is(err, services.ErrUnknownInstrument):
+		return status.New(codes.InvalidArgument, err.Error())
+	}
+	return serviceStatus("failed to create reward", err)
+}
+
+// serviceStatus maps any other service error: DeadlineExceeded when the call ran
+// out of time, Canceled when the caller went away, Internal otherwise
+func serviceStatus(msg string, err error) *status.Status {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, fmt.Sprintf("%s: %v", msg, err))
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, fmt.Sprintf("%s: %v", msg, err))
+	}
+	return status.New(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+}
+
+// withErrorInfo attaches info to st, keeping st as is if it can't be attached
+func withErrorInfo(st *status.Status, info *errdetails.ErrorInfo) *status.Status {
+	if detailed, err := st.WithDetails(info); err == nil {
+		return detailed
+	}
+	return st
+}
@@ -0,0 +1,124 @@
+// Package grpcserver serves the gRPC API for internal services. It calls the same
+// services as the Gin handlers, so rewards issued over gRPC go through the same
+// idempotency, budget and risk checks and are audited the same way.
+package grpcserver
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/services"
+	stockyv1 "github.com/stocky/assignment/pkg/pb/stocky/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataRequestID is the gRPC equivalent of the REST API's X-Request-ID header
+const MetadataRequestID = "x-request-id"
+
+// NewServer returns a gRPC server with RewardService, the standard health service
+// and reflection registered. Every call gets requestTimeout, the REST API's
+// per-request deadline, unless the caller set a shorter one. Nil limits turn
+// rate limiting off.
+func NewServer(rewardService services.RewardService, requestTimeout time.Duration, limits *RateLimits, log *logrus.Logger) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			requestContextInterceptor(log),
+			recoveryInterceptor(log),
+			loggingInterceptor(log),
+			rateLimitInterceptor(limits, log),
+			timeoutInterceptor(requestTimeout),
+		),
+	)
+
+	stockyv1.RegisterRewardServiceServer(srv, NewRewardServer(rewardService, requestTimeout, limits, log))
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(stockyv1.RewardService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	// Lets grpcurl and similar tools list and call the services without the .proto files
+	reflection.Register(srv)
+	return srv
+}
+
+// requestContextInterceptor puts the request ID and request log entry into the
+// context, as the REST API's RequestContextMiddleware does, and returns the request
+// ID in the response header. The gRPC API authenticates nobody, so calls act as
+// anonymous whatever metadata they send.
+func requestContextInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx, requestID := requestctx.Begin(ctx, firstValue(md, MetadataRequestID), log)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(MetadataRequestID, requestID)); err != nil {
+			requestctx.Logger(ctx, log).Warnf("Failed to set gRPC response header: %v", err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// recoveryInterceptor turns a panic into an Internal error
+func recoveryInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				requestctx.Logger(ctx, log).WithFields(logrus.Fields{
+					"error":  r,
+					"method": info.FullMethod,
+					"stack":  string(debug.Stack()),
+				}).Error("Panic recovered")
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// loggingInterceptor logs each call and records its latency
+func loggingInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(startTime)
+		code := status.Code(err)
+
+		metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod, code.String()).Observe(duration.Seconds())
+		requestctx.Logger(ctx, log).WithFields(logrus.Fields{
+			"method":      info.FullMethod,
+			"code":        code.String(),
+			"duration_ms": duration.Milliseconds(),
+		}).Info("gRPC request")
+		return resp, err
+	}
+}
+
+// timeoutInterceptor bounds each call like the REST API's TimeoutMiddleware. A batch
+// is bounded per reward instead, by the handler.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if timeout <= 0 || info.FullMethod == stockyv1.RewardService_BatchCreateRewards_FullMethodName {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
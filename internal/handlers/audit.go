@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// maxAuditEntriesListed caps GET /admin/audit-log
+const maxAuditEntriesListed = 500
+
+type AuditHandler struct {
+	auditService services.AuditService
+	log          *logrus.Logger
+}
+
+func NewAuditHandler(auditService services.AuditService, log *logrus.Logger) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+		log:          log,
+	}
+}
+
+// ListEntries handles GET /admin/audit-log?entity_type=&entity_id=&actor=&action=&since=&until=&after_id=&limit=
+// Entries come back oldest first; pass the last id as after_id to read the next page.
+func (h *AuditHandler) ListEntries(c *gin.Context) {
+	filter := models.AuditFilter{
+		EntityType: c.Query("entity_type"),
+		EntityID:   c.Query("entity_id"),
+		Actor:      c.Query("actor"),
+		Action:     c.Query("action"),
+		Limit:      100,
+	}
+
+	for param, dst := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if raw := c.Query(param); raw != "" {
+			parsed, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResponse{
+					Error:   "Invalid '" + param + "' timestamp, expected RFC3339",
+					Details: err.Error(),
+				})
+				return
+			}
+			*dst = parsed
+		}
+	}
+
+	if raw := c.Query("after_id"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "after_id must be a non-negative integer",
+			})
+			return
+		}
+		filter.AfterID = parsed
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > maxAuditEntriesListed {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "limit must be between 1 and " + strconv.Itoa(maxAuditEntriesListed),
+			})
+			return
+		}
+		filter.Limit = parsed
+	}
+
+	entries, err := h.auditService.ListEntries(c.Request.Context(), filter)
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidAuditQuery) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to list audit log: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to fetch audit log",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, AuditLogResponse{
+		Success: true,
+		Count:   len(entries),
+		Data:    entries,
+	})
+}
+
+// VerifyChain handles GET /admin/audit-log/verify
+func (h *AuditHandler) VerifyChain(c *gin.Context) {
+	result, err := h.auditService.VerifyChain(c.Request.Context())
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to verify audit log: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to verify audit log",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// A broken chain is a finding, not a failed request
+	c.JSON(http.StatusOK, AuditVerificationResponse{
+		Success: true,
+		Data:    result,
+	})
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// maxBudgetOverridesListed caps GET /admin/budget-overrides
+const maxBudgetOverridesListed = 500
+
+// OverrideRewardRequest is a reward request plus the approval that lets it exceed its budgets
+type OverrideRewardRequest struct {
+	services.RewardRequest
+	Override services.BudgetOverride `json:"budget_override" binding:"required"`
+}
+
+// CreateRewardWithOverride handles POST /admin/rewards
+func (h *RewardHandler) CreateRewardWithOverride(c *gin.Context) {
+	var req OverrideRewardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if req.RewardedAt.IsZero() {
+		req.RewardedAt = time.Now()
+	}
+
+	event, err := h.rewardService.CreateRewardWithOverride(c.Request.Context(), &req.RewardRequest, &req.Override)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to create reward with budget override: %v", err)
+		h.rewardError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, RewardResponse{
+		Success: true,
+		Data:    event,
+	})
+}
+
+// ListBudgetOverrides handles GET /admin/budget-overrides?user_id=&limit=
+func (h *RewardHandler) ListBudgetOverrides(c *gin.Context) {
+	limit := 100
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > maxBudgetOverridesListed {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "limit must be between 1 and " + strconv.Itoa(maxBudgetOverridesListed),
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	overrides, err := h.rewardService.ListBudgetOverrides(c.Request.Context(), c.Query("user_id"), limit)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to list budget overrides: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch budget overrides",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, BudgetOverrideListResponse{
+		Success: true,
+		Count:   len(overrides),
+		Data:    overrides,
+	})
+}
+
+// rewardError writes the response for a reward that was not created. Budget breaches
+// are 422 and carry the limit that was exceeded. A reward held by the risk checks is
+// 202 with its review; a denied one is 403.
+func (h *RewardHandler) rewardError(c *gin.Context, err error) {
+	var budgetErr *services.BudgetExceededError
+	var heldErr *services.RewardHeldError
+	var deniedErr *services.RewardDeniedError
+	switch {
+	case errors.As(err, &heldErr):
+		c.JSON(http.StatusAccepted, RewardHeldResponse{
+			Success: true,
+			Status:  services.ReviewPending,
+			Details: err.Error(),
+			Data:    heldErr.Review,
+		})
+		return
+	case errors.As(err, &deniedErr):
+		c.JSON(http.StatusForbidden, RewardDeniedResponse{
+			Error:    "Reward denied",
+			Details:  err.Error(),
+			ReviewID: deniedErr.Review.ID,
+		})
+		return
+	case errors.As(err, &budgetErr):
+		c.JSON(http.StatusUnprocessableEntity, BudgetExceededResponse{
+			Error:   "Reward budget exceeded",
+			Details: err.Error(),
+			Budget:  budgetErr.Breach,
+		})
+		return
+	case errors.Is(err, services.ErrInvalidVesting), errors.Is(err, services.ErrInvalidBudgetOverride),
+		errors.Is(err, services.ErrInvalidInstrument), errors.Is(err, services.ErrUnknownInstrument):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to create reward",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(errorStatus(err), ErrorResponse{
+		Error:   "Failed to create reward",
+		Details: err.Error(),
+	})
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type DisposalHandler struct {
+	disposalService services.DisposalService
+	log             *logrus.Logger
+}
+
+func NewDisposalHandler(disposalService services.DisposalService, log *logrus.Logger) *DisposalHandler {
+	return &DisposalHandler{
+		disposalService: disposalService,
+		log:             log,
+	}
+}
+
+// disposalErrorStatus maps disposal errors, reporting over-sells as 422
+func disposalErrorStatus(err error) int {
+	if errors.Is(err, services.ErrInsufficientShares) {
+		return http.StatusUnprocessableEntity
+	}
+	return errorStatus(err)
+}
+
+// Sell handles POST /sell
+func (h *DisposalHandler) Sell(c *gin.Context) {
+	var req services.SellRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		requestLogger(c, h.log).Errorf("Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	disposal, err := h.disposalService.Sell(c.Request.Context(), &req)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to sell shares: %v", err)
+		c.JSON(disposalErrorStatus(err), ErrorResponse{
+			Error:   "Failed to sell shares",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, DisposalResponse{
+		Success: true,
+		Data:    disposal,
+	})
+}
+
+// TransferOut handles POST /transfer-out
+func (h *DisposalHandler) TransferOut(c *gin.Context) {
+	var req services.TransferOutRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		requestLogger(c, h.log).Errorf("Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	disposal, err := h.disposalService.TransferOut(c.Request.Context(), &req)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to transfer shares: %v", err)
+		c.JSON(disposalErrorStatus(err), ErrorResponse{
+			Error:   "Failed to transfer shares",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, DisposalResponse{
+		Success: true,
+		Data:    disposal,
+	})
+}
+
+// GetDisposals handles GET /disposals/:userId
+func (h *DisposalHandler) GetDisposals(c *gin.Context) {
+	userID := c.Param("userId")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
+		})
+		return
+	}
+
+	disposals, err := h.disposalService.GetUserDisposals(c.Request.Context(), userID)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to get disposals: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch disposals",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, DisposalListResponse{
+		Success: true,
+		UserID:  userID,
+		Count:   len(disposals),
+		Data:    disposals,
+	})
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type FeeScheduleHandler struct {
+	feeService services.FeeScheduleService
+	log        *logrus.Logger
+}
+
+func NewFeeScheduleHandler(feeService services.FeeScheduleService, log *logrus.Logger) *FeeScheduleHandler {
+	return &FeeScheduleHandler{
+		feeService: feeService,
+		log:        log,
+	}
+}
+
+// ListFeeSchedules handles GET /fee-schedules
+func (h *FeeScheduleHandler) ListFeeSchedules(c *gin.Context) {
+	schedules, err := h.feeService.ListSchedules(c.Request.Context())
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to list fee schedules: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch fee schedules",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, FeeScheduleListResponse{
+		Success: true,
+		Count:   len(schedules),
+		Data:    schedules,
+	})
+}
+
+// GetEffectiveFeeSchedule handles GET /fee-schedules/effective?at=RFC3339
+func (h *FeeScheduleHandler) GetEffectiveFeeSchedule(c *gin.Context) {
+	at := time.Now()
+	if atStr := c.Query("at"); atStr != "" {
+		parsed, err := time.Parse(time.RFC3339, atStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid 'at' timestamp, expected RFC3339",
+				Details: err.Error(),
+			})
+			return
+		}
+		at = parsed
+	}
+
+	schedule, err := h.feeService.GetEffectiveSchedule(c.Request.Context(), at)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to get effective fee schedule: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch fee schedule",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, FeeScheduleResponse{
+		Success: true,
+		Data:    schedule,
+	})
+}
+
+// CreateFeeSchedule handles POST /admin/fee-schedules
+func (h *FeeScheduleHandler) CreateFeeSchedule(c *gin.Context) {
+	var req services.FeeScheduleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	schedule, err := h.feeService.CreateSchedule(c.Request.Context(), &req)
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidFeeSchedule) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to create fee schedule: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to create fee schedule",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, FeeScheduleResponse{
+		Success: true,
+		Data:    schedule,
+	})
+}
@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/requestctx"
 	"github.com/stocky/assignment/internal/services"
 )
 
@@ -21,15 +24,28 @@ func NewRewardHandler(rewardService services.RewardService, log *logrus.Logger)
 	}
 }
 
+// errorStatus maps service errors to HTTP status codes, surfacing request timeouts as 504
+func errorStatus(err error) int {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// requestLogger returns the request's log entry, tagged with its request and trace IDs
+func requestLogger(c *gin.Context, log *logrus.Logger) *logrus.Entry {
+	return requestctx.Logger(c.Request.Context(), log)
+}
+
 // CreateReward handles POST /reward
 func (h *RewardHandler) CreateReward(c *gin.Context) {
 	var req services.RewardRequest
 	
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.log.Errorf("Invalid request body: %v", err)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid request",
-			"details": err.Error(),
+		requestLogger(c, h.log).Errorf("Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
 		})
 		return
 	}
@@ -39,19 +55,16 @@ func (h *RewardHandler) CreateReward(c *gin.Context) {
 		req.RewardedAt = time.Now()
 	}
 	
-	event, err := h.rewardService.CreateReward(&req)
+	event, err := h.rewardService.CreateReward(c.Request.Context(), &req)
 	if err != nil {
-		h.log.Errorf("Failed to create reward: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to create reward",
-			"details": err.Error(),
-		})
+		requestLogger(c, h.log).Errorf("Failed to create reward: %v", err)
+		h.rewardError(c, err)
 		return
 	}
 	
-	c.JSON(http.StatusCreated, gin.H{
-		"success": true,
-		"data":    event,
+	c.JSON(http.StatusCreated, RewardResponse{
+		Success: true,
+		Data:    event,
 	})
 }
 
@@ -60,28 +73,28 @@ func (h *RewardHandler) GetTodayStocks(c *gin.Context) {
 	userID := c.Param("userId")
 	
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "user_id is required",
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
 		})
 		return
 	}
 	
-	rewards, err := h.rewardService.GetTodayStocks(userID)
+	rewards, err := h.rewardService.GetTodayStocks(c.Request.Context(), userID)
 	if err != nil {
-		h.log.Errorf("Failed to get today's stocks: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to fetch today's stocks",
-			"details": err.Error(),
+		requestLogger(c, h.log).Errorf("Failed to get today's stocks: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch today's stocks",
+			Details: err.Error(),
 		})
 		return
 	}
 	
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"user_id": userID,
-		"date":    time.Now().Format("2006-01-02"),
-		"count":   len(rewards),
-		"data":    rewards,
+	c.JSON(http.StatusOK, TodayStocksResponse{
+		Success: true,
+		UserID:  userID,
+		Date:    time.Now().Format("2006-01-02"),
+		Count:   len(rewards),
+		Data:    rewards,
 	})
 }
 
@@ -90,25 +103,25 @@ func (h *RewardHandler) GetHistoricalINR(c *gin.Context) {
 	userID := c.Param("userId")
 	
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "user_id is required",
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
 		})
 		return
 	}
 	
-	historical, err := h.rewardService.GetHistoricalINR(userID)
+	historical, err := h.rewardService.GetHistoricalINR(c.Request.Context(), userID)
 	if err != nil {
-		h.log.Errorf("Failed to get historical INR: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to fetch historical INR data",
-			"details": err.Error(),
+		requestLogger(c, h.log).Errorf("Failed to get historical INR: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch historical INR data",
+			Details: err.Error(),
 		})
 		return
 	}
 	
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data":    historical,
+	c.JSON(http.StatusOK, HistoricalINRDataResponse{
+		Success: true,
+		Data:    historical,
 	})
 }
 
@@ -117,25 +130,25 @@ func (h *RewardHandler) GetStats(c *gin.Context) {
 	userID := c.Param("userId")
 	
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "user_id is required",
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
 		})
 		return
 	}
 	
-	stats, err := h.rewardService.GetUserStats(userID)
+	stats, err := h.rewardService.GetUserStats(c.Request.Context(), userID)
 	if err != nil {
-		h.log.Errorf("Failed to get user stats: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to fetch user stats",
-			"details": err.Error(),
+		requestLogger(c, h.log).Errorf("Failed to get user stats: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch user stats",
+			Details: err.Error(),
 		})
 		return
 	}
 	
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data":    stats,
+	c.JSON(http.StatusOK, UserStatsDataResponse{
+		Success: true,
+		Data:    stats,
 	})
 }
 
@@ -144,18 +157,18 @@ func (h *RewardHandler) GetPortfolio(c *gin.Context) {
 	userID := c.Param("userId")
 	
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "user_id is required",
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
 		})
 		return
 	}
 	
-	portfolio, err := h.rewardService.GetUserPortfolio(userID)
+	portfolio, err := h.rewardService.GetUserPortfolio(c.Request.Context(), userID)
 	if err != nil {
-		h.log.Errorf("Failed to get portfolio: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to fetch portfolio",
-			"details": err.Error(),
+		requestLogger(c, h.log).Errorf("Failed to get portfolio: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch portfolio",
+			Details: err.Error(),
 		})
 		return
 	}
@@ -168,23 +181,23 @@ func (h *RewardHandler) GetPortfolio(c *gin.Context) {
 		totalCost += item.TotalCost
 	}
 	
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"user_id": userID,
-		"summary": gin.H{
-			"total_value":      totalValue,
-			"total_cost":       totalCost,
-			"total_profit_loss": totalValue - totalCost,
-			"holdings_count":   len(portfolio),
+	c.JSON(http.StatusOK, PortfolioResponse{
+		Success: true,
+		UserID:  userID,
+		Summary: PortfolioSummary{
+			TotalValue:      totalValue,
+			TotalCost:       totalCost,
+			TotalProfitLoss: totalValue - totalCost,
+			HoldingsCount:   len(portfolio),
 		},
-		"holdings": portfolio,
+		Holdings: portfolio,
 	})
 }
 
 // HealthCheck handles GET /health
 func (h *RewardHandler) HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status":    "healthy",
-		"timestamp": time.Now().Format(time.RFC3339),
+	c.JSON(http.StatusOK, HealthResponse{
+		Status:    "healthy",
+		Timestamp: time.Now().Format(time.RFC3339),
 	})
 }
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type HealthHandler struct {
+	healthService services.HealthService
+	log           *logrus.Logger
+}
+
+func NewHealthHandler(healthService services.HealthService, log *logrus.Logger) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		log:           log,
+	}
+}
+
+// Liveness handles GET /livez. It only reports that the process can serve
+// HTTP; dependency failures must not trigger a restart.
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, HealthResponse{
+		Status:    "alive",
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
+// Readiness handles GET /readyz. It returns 503 when any dependency is
+// degraded so that the load balancer stops routing traffic to this pod.
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	report := h.healthService.Readiness(c.Request.Context())
+
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, report)
+}
@@ -0,0 +1,338 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stocky/assignment/internal/openapi"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// APIVersion is the version of the HTTP API published in the OpenAPI spec
+const APIVersion = "1.0.0"
+
+// apiRoutes documents every route the server registers. main checks it against
+// the router on startup, so a route added without an entry here is reported.
+var apiRoutes = []openapi.Route{
+	{
+		Method: http.MethodGet, Path: "/health", OperationID: "healthCheck", Tag: "health",
+		Summary: "Basic health check",
+		Replies: []openapi.Reply{replied(http.StatusOK, HealthResponse{})},
+	},
+	{
+		Method: http.MethodGet, Path: "/livez", OperationID: "liveness", Tag: "health",
+		Summary: "Liveness probe; only reports that the process can serve HTTP",
+		Replies: []openapi.Reply{replied(http.StatusOK, HealthResponse{})},
+	},
+	{
+		Method: http.MethodGet, Path: "/readyz", OperationID: "readiness", Tag: "health",
+		Summary: "Readiness probe with the state of every dependency",
+		Replies: []openapi.Reply{
+			replied(http.StatusOK, services.ReadinessReport{}),
+			{Status: http.StatusServiceUnavailable, Description: "A dependency is degraded", Body: services.ReadinessReport{}},
+		},
+	},
+	{
+		Method: http.MethodGet, Path: "/metrics", OperationID: "metrics", Tag: "health",
+		Summary: "Prometheus metrics",
+		Replies: []openapi.Reply{{Status: http.StatusOK, ContentType: "text/plain"}},
+	},
+	{
+		Method: http.MethodGet, Path: "/openapi.json", OperationID: "getOpenAPISpec", Tag: "health",
+		Summary: "This OpenAPI document",
+		Replies: []openapi.Reply{{Status: http.StatusOK}},
+	},
+
+	// Rewards
+	{
+		Method: http.MethodPost, Path: "/api/v1/reward", OperationID: "createReward", Tag: "rewards",
+		Summary:     "Award shares to a user",
+		Description: "Replaying an idempotency_key returns the original reward. A reward held by the risk checks is 202 with its review.",
+		Body:        services.RewardRequest{},
+		Replies:     rewardReplies(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/today-stocks/:userId", OperationID: "getTodayStocks", Tag: "rewards",
+		Summary: "Rewards a user received today",
+		Replies: apiReplies(replied(http.StatusOK, TodayStocksResponse{}), failed(http.StatusBadRequest)),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/historical-inr/:userId", OperationID: "getHistoricalINR", Tag: "rewards",
+		Summary: "INR value of a user's rewards for each past day",
+		Replies: apiReplies(replied(http.StatusOK, HistoricalINRDataResponse{}), failed(http.StatusBadRequest)),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/stats/:userId", OperationID: "getStats", Tag: "rewards",
+		Summary: "Today's rewards by stock and the current portfolio value",
+		Replies: apiReplies(replied(http.StatusOK, UserStatsDataResponse{}), failed(http.StatusBadRequest)),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/portfolio/:userId", OperationID: "getPortfolio", Tag: "rewards",
+		Summary: "A user's holdings valued at current prices",
+		Replies: apiReplies(replied(http.StatusOK, PortfolioResponse{}), failed(http.StatusBadRequest)),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/rewards/:id/invalidate", OperationID: "invalidateReward", Tag: "rewards",
+		Summary: "Reverse a reward, forfeiting its unvested shares",
+		Body:    InvalidateRewardRequest{},
+		Replies: adminReplies(apiReplies(
+			replied(http.StatusOK, InvalidationResponse{}),
+			failed(http.StatusBadRequest),
+			failed(http.StatusNotFound),
+			failed(http.StatusConflict),
+		)),
+	},
+
+	// Fees and tax
+	{
+		Method: http.MethodGet, Path: "/api/v1/fee-schedules", OperationID: "listFeeSchedules", Tag: "fees",
+		Summary: "All fee schedule versions",
+		Replies: apiReplies(replied(http.StatusOK, FeeScheduleListResponse{})),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/fee-schedules/effective", OperationID: "getEffectiveFeeSchedule", Tag: "fees",
+		Summary: "The fee schedule in force at a time",
+		Query:   []openapi.Parameter{query("at", "RFC3339 time, defaults to now", openapi.DateTime())},
+		Replies: apiReplies(replied(http.StatusOK, FeeScheduleResponse{}), failed(http.StatusBadRequest)),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/fee-schedules", OperationID: "createFeeSchedule", Tag: "fees",
+		Summary: "Publish a new fee schedule version",
+		Body:    services.FeeScheduleRequest{},
+		Replies: adminReplies(apiReplies(replied(http.StatusCreated, FeeScheduleResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/tax-report/:userId", OperationID: "getTaxReport", Tag: "fees",
+		Summary: "Perquisites and capital gains for a financial year",
+		Query: []openapi.Parameter{
+			query("fy", "Financial year, e.g. 2024-25; defaults to the current one", openapi.String()),
+			query("format", "Response format, defaults to json", openapi.String("json", "csv")),
+		},
+		Replies: apiReplies(
+			replied(http.StatusOK, TaxReportResponse{}),
+			openapi.Reply{Status: http.StatusOK, ContentType: "text/csv"},
+			failed(http.StatusBadRequest),
+		),
+	},
+
+	// Disposals
+	{
+		Method: http.MethodPost, Path: "/api/v1/sell", OperationID: "sell", Tag: "disposals",
+		Summary: "Sell vested, settled shares",
+		Body:    services.SellRequest{},
+		Replies: disposalReplies(),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/transfer-out", OperationID: "transferOut", Tag: "disposals",
+		Summary: "Transfer vested, settled shares to a demat account",
+		Body:    services.TransferOutRequest{},
+		Replies: disposalReplies(),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/disposals/:userId", OperationID: "getDisposals", Tag: "disposals",
+		Summary: "A user's sells and transfers",
+		Replies: apiReplies(replied(http.StatusOK, DisposalListResponse{}), failed(http.StatusBadRequest)),
+	},
+
+	// Webhooks
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/webhooks/subscriptions", OperationID: "createWebhookSubscription", Tag: "webhooks",
+		Summary: "Subscribe a URL to events; the signing secret is only returned here",
+		Body:    services.WebhookSubscriptionRequest{},
+		Replies: adminReplies(apiReplies(replied(http.StatusCreated, WebhookSubscriptionCreatedResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/webhooks/subscriptions", OperationID: "listWebhookSubscriptions", Tag: "webhooks",
+		Summary: "All webhook subscriptions",
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, WebhookSubscriptionListResponse{}))),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/admin/webhooks/subscriptions/:id", OperationID: "deactivateWebhookSubscription", Tag: "webhooks",
+		Summary: "Stop delivering to a subscription",
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, SuccessResponse{}), failed(http.StatusBadRequest), failed(http.StatusNotFound))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/webhooks/deliveries", OperationID: "listWebhookDeliveries", Tag: "webhooks",
+		Summary: "Recent webhook deliveries",
+		Query: []openapi.Parameter{
+			query("status", "Only deliveries in this state", openapi.String(services.DeliveryPending, services.DeliveryDelivered, services.DeliveryDead)),
+		},
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, WebhookDeliveryListResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/webhooks/deliveries/:id/replay", OperationID: "replayWebhookDelivery", Tag: "webhooks",
+		Summary: "Queue a delivery to be sent again",
+		Replies: adminReplies(apiReplies(replied(http.StatusAccepted, SuccessResponse{}), failed(http.StatusBadRequest), failed(http.StatusNotFound))),
+	},
+
+	// Admin
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/rewards", OperationID: "createRewardWithOverride", Tag: "admin",
+		Summary: "Award shares beyond the reward budgets with an approved override",
+		Body:    OverrideRewardRequest{},
+		Replies: adminReplies(rewardReplies()),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/budget-overrides", OperationID: "listBudgetOverrides", Tag: "admin",
+		Summary: "Rewards issued over budget, newest first",
+		Query: []openapi.Parameter{
+			query("user_id", "Only overrides for this user", openapi.String()),
+			query("limit", "At most 500, defaults to 100", openapi.Integer()),
+		},
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, BudgetOverrideListResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/reward-reviews", OperationID: "listRewardReviews", Tag: "admin",
+		Summary: "Rewards held or denied by the risk checks, oldest first",
+		Query: []openapi.Parameter{
+			query("status", "Defaults to pending", openapi.String(services.ReviewPending, services.ReviewApproved, services.ReviewRejected, services.ReviewDenied)),
+			query("limit", "At most 500, defaults to 100", openapi.Integer()),
+		},
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, RewardReviewListResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/reward-reviews/:id/approve", OperationID: "approveRewardReview", Tag: "admin",
+		Summary: "Issue a held reward",
+		Body:    services.ReviewDecision{},
+		Replies: adminReplies(append(rewardReplies(), failed(http.StatusNotFound), failed(http.StatusConflict))),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/reward-reviews/:id/reject", OperationID: "rejectRewardReview", Tag: "admin",
+		Summary: "Reject a held reward",
+		Body:    services.ReviewDecision{},
+		Replies: adminReplies(apiReplies(
+			replied(http.StatusOK, RewardReviewResponse{}),
+			failed(http.StatusBadRequest),
+			failed(http.StatusNotFound),
+			failed(http.StatusConflict),
+		)),
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/risk/blocklist", OperationID: "addBlocklistEntry", Tag: "admin",
+		Summary: "Block a user, device or IP range from receiving rewards",
+		Body:    services.BlocklistRequest{},
+		Replies: adminReplies(apiReplies(replied(http.StatusCreated, BlocklistEntryResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/risk/blocklist", OperationID: "listBlocklist", Tag: "admin",
+		Summary: "The reward blocklist",
+		Query: []openapi.Parameter{
+			query("kind", "Only entries of this kind", openapi.String(services.BlockUser, services.BlockDevice, services.BlockIP)),
+		},
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, BlocklistResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/admin/risk/blocklist/:id", OperationID: "removeBlocklistEntry", Tag: "admin",
+		Summary: "Remove a blocklist entry",
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, SuccessResponse{}), failed(http.StatusBadRequest), failed(http.StatusNotFound))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/audit-log", OperationID: "listAuditLog", Tag: "admin",
+		Summary: "Audit log entries, oldest first; pass the last id as after_id for the next page",
+		Query: []openapi.Parameter{
+			query("entity_type", "", openapi.String()),
+			query("entity_id", "", openapi.String()),
+			query("actor", "", openapi.String()),
+			query("action", "", openapi.String()),
+			query("since", "RFC3339 time", openapi.DateTime()),
+			query("until", "RFC3339 time", openapi.DateTime()),
+			query("after_id", "Only entries after this id", openapi.Integer()),
+			query("limit", "At most 500, defaults to 100", openapi.Integer()),
+		},
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, AuditLogResponse{}), failed(http.StatusBadRequest))),
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/audit-log/verify", OperationID: "verifyAuditLog", Tag: "admin",
+		Summary: "Recompute the audit log hash chain; a broken chain is reported in the body, not as an error",
+		Replies: adminReplies(apiReplies(replied(http.StatusOK, AuditVerificationResponse{}))),
+	},
+
+	// Streaming
+	{
+		Method: http.MethodGet, Path: "/api/v1/stream/:userId", OperationID: "streamPortfolio", Tag: "rewards",
+		Summary: "Server-Sent Events with the valued portfolio and prices of held stocks",
+		Replies: []openapi.Reply{
+			{Status: http.StatusOK, Description: "portfolio and price events", ContentType: "text/event-stream"},
+			failed(http.StatusTooManyRequests),
+			failed(http.StatusInternalServerError),
+		},
+	},
+}
+
+var apiTags = []openapi.Tag{
+	{Name: "rewards", Description: "Issuing rewards and reading holdings"},
+	{Name: "fees", Description: "Fee schedules and tax reports"},
+	{Name: "disposals", Description: "Selling and transferring out shares"},
+	{Name: "webhooks", Description: "Event subscriptions and deliveries; admin routes"},
+	{Name: "admin", Description: "Budget overrides, risk review and the audit log"},
+	{Name: "health", Description: "Probes, metrics and this spec"},
+}
+
+const apiDescription = `Stock rewards for users, with holdings, valuations, disposals and tax reports.
+
+Every response carries an X-Request-ID header, echoed from the request when it sends a valid one.
+Routes under /api/v1/admin need "Authorization: Bearer <token>" with an admin's token from ADMIN_API_TOKENS;
+that admin is recorded as the actor in the audit log. Other requests are recorded as anonymous.
+Routes under /api/v1 are rate limited per X-Client-ID, user and IP; a 429 carries Retry-After.`
+
+func replied(status int, body interface{}) openapi.Reply {
+	return openapi.Reply{Status: status, Body: body}
+}
+
+func failed(status int) openapi.Reply {
+	return openapi.Reply{Status: status, Body: ErrorResponse{}}
+}
+
+func query(name, description string, schema *openapi.Schema) openapi.Parameter {
+	return openapi.Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+// apiReplies adds the failures every /api/v1 route can return: rate limiting,
+// internal errors and the request timeout
+func apiReplies(replies ...openapi.Reply) []openapi.Reply {
+	return append(replies,
+		failed(http.StatusTooManyRequests),
+		failed(http.StatusInternalServerError),
+		failed(http.StatusGatewayTimeout),
+	)
+}
+
+// adminReplies adds the failures of the admin token check to an /api/v1/admin route
+func adminReplies(replies []openapi.Reply) []openapi.Reply {
+	return append(replies,
+		openapi.Reply{Status: http.StatusUnauthorized, Description: "Missing or wrong admin token", Body: ErrorResponse{}},
+		openapi.Reply{Status: http.StatusServiceUnavailable, Description: "No admin token is configured", Body: ErrorResponse{}},
+	)
+}
+
+func rewardReplies() []openapi.Reply {
+	return apiReplies(
+		replied(http.StatusCreated, RewardResponse{}),
+		openapi.Reply{Status: http.StatusAccepted, Description: "Held for review by the risk checks", Body: RewardHeldResponse{}},
+		failed(http.StatusBadRequest),
+		openapi.Reply{Status: http.StatusForbidden, Description: "Denied by the risk checks", Body: RewardDeniedResponse{}},
+		openapi.Reply{Status: http.StatusUnprocessableEntity, Description: "Over a reward budget", Body: BudgetExceededResponse{}},
+	)
+}
+
+func disposalReplies() []openapi.Reply {
+	return apiReplies(
+		replied(http.StatusCreated, DisposalResponse{}),
+		failed(http.StatusBadRequest),
+		openapi.Reply{Status: http.StatusUnprocessableEntity, Description: "More shares than are vested and settled", Body: ErrorResponse{}},
+	)
+}
+
+// APISpec builds the OpenAPI document for the HTTP API
+func APISpec() (*openapi.Document, error) {
+	info := openapi.Info{Title: "Stocky API", Description: apiDescription, Version: APIVersion}
+	servers := []openapi.Server{{URL: "http://localhost:8080", Description: "Local development"}}
+	return openapi.Build(info, servers, apiTags, apiRoutes)
+}
+
+// OpenAPI serves GET /openapi.json
+func OpenAPI(spec *openapi.Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	}
+}
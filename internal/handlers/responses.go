@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// Response bodies for every endpoint. The OpenAPI spec and the generated client are
+// built from these types, so a field added here shows up in both.
+
+// ErrorResponse is returned by every endpoint on failure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Details string `json:"details,omitempty"`
+}
+
+// SuccessResponse acknowledges a request that returns no data
+type SuccessResponse struct {
+	Success bool `json:"success"`
+}
+
+type HealthResponse struct {
+	Status    string `json:"status"`
+	Timestamp string `json:"timestamp"`
+}
+
+type RewardResponse struct {
+	Success bool                `json:"success"`
+	Data    *models.RewardEvent `json:"data"`
+}
+
+// RewardHeldResponse is the 202 for a reward held by the risk checks
+type RewardHeldResponse struct {
+	Success bool                 `json:"success"`
+	Status  string               `json:"status"`
+	Details string               `json:"details"`
+	Data    *models.RewardReview `json:"data"`
+}
+
+// RewardDeniedResponse is the 403 for a reward denied by the risk checks
+type RewardDeniedResponse struct {
+	Error    string `json:"error"`
+	Details  string `json:"details"`
+	ReviewID int64  `json:"review_id"`
+}
+
+// BudgetExceededResponse is the 422 for a reward over one of its budgets
+type BudgetExceededResponse struct {
+	Error   string              `json:"error"`
+	Details string              `json:"details"`
+	Budget  models.BudgetBreach `json:"budget"`
+}
+
+type TodayStocksResponse struct {
+	Success bool                 `json:"success"`
+	UserID  string               `json:"user_id"`
+	Date    string               `json:"date"`
+	Count   int                  `json:"count"`
+	Data    []models.RewardEvent `json:"data"`
+}
+
+type HistoricalINRDataResponse struct {
+	Success bool                            `json:"success"`
+	Data    *services.HistoricalINRResponse `json:"data"`
+}
+
+type UserStatsDataResponse struct {
+	Success bool                        `json:"success"`
+	Data    *services.UserStatsResponse `json:"data"`
+}
+
+type PortfolioResponse struct {
+	Success  bool                   `json:"success"`
+	UserID   string                 `json:"user_id"`
+	Summary  PortfolioSummary       `json:"summary"`
+	Holdings []models.PortfolioItem `json:"holdings"`
+}
+
+type PortfolioSummary struct {
+	TotalValue      float64 `json:"total_value"`
+	TotalCost       float64 `json:"total_cost"`
+	TotalProfitLoss float64 `json:"total_profit_loss"`
+	HoldingsCount   int     `json:"holdings_count"`
+}
+
+type FeeScheduleResponse struct {
+	Success bool                `json:"success"`
+	Data    *models.FeeSchedule `json:"data"`
+}
+
+type FeeScheduleListResponse struct {
+	Success bool                 `json:"success"`
+	Count   int                  `json:"count"`
+	Data    []models.FeeSchedule `json:"data"`
+}
+
+type TaxReportResponse struct {
+	Success bool                `json:"success"`
+	Data    *services.TaxReport `json:"data"`
+}
+
+type DisposalResponse struct {
+	Success bool             `json:"success"`
+	Data    *models.Disposal `json:"data"`
+}
+
+type DisposalListResponse struct {
+	Success bool              `json:"success"`
+	UserID  string            `json:"user_id"`
+	Count   int               `json:"count"`
+	Data    []models.Disposal `json:"data"`
+}
+
+type InvalidationResponse struct {
+	Success bool                         `json:"success"`
+	Data    *services.InvalidationResult `json:"data"`
+}
+
+// WebhookSubscriptionCreatedResponse carries the signing secret, which is only ever returned here
+type WebhookSubscriptionCreatedResponse struct {
+	Success bool                        `json:"success"`
+	Data    *models.WebhookSubscription `json:"data"`
+	Secret  string                      `json:"secret"`
+}
+
+type WebhookSubscriptionListResponse struct {
+	Success bool                         `json:"success"`
+	Count   int                          `json:"count"`
+	Data    []models.WebhookSubscription `json:"data"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Success bool                     `json:"success"`
+	Count   int                      `json:"count"`
+	Data    []models.WebhookDelivery `json:"data"`
+}
+
+type BudgetOverrideListResponse struct {
+	Success bool                          `json:"success"`
+	Count   int                           `json:"count"`
+	Data    []models.RewardBudgetOverride `json:"data"`
+}
+
+type RewardReviewResponse struct {
+	Success bool                 `json:"success"`
+	Data    *models.RewardReview `json:"data"`
+}
+
+type RewardReviewListResponse struct {
+	Success bool                  `json:"success"`
+	Count   int                   `json:"count"`
+	Data    []models.RewardReview `json:"data"`
+}
+
+type BlocklistEntryResponse struct {
+	Success bool                   `json:"success"`
+	Data    *models.BlocklistEntry `json:"data"`
+}
+
+type BlocklistResponse struct {
+	Success bool                    `json:"success"`
+	Count   int                     `json:"count"`
+	Data    []models.BlocklistEntry `json:"data"`
+}
+
+type AuditLogResponse struct {
+	Success bool                `json:"success"`
+	Count   int                 `json:"count"`
+	Data    []models.AuditEntry `json:"data"`
+}
+
+type AuditVerificationResponse struct {
+	Success bool                        `json:"success"`
+	Data    *services.AuditVerification `json:"data"`
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// maxRewardReviewsListed caps GET /admin/reward-reviews
+const maxRewardReviewsListed = 500
+
+// ListRewardReviews handles GET /admin/reward-reviews?status=&limit=
+func (h *RewardHandler) ListRewardReviews(c *gin.Context) {
+	limit := 100
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > maxRewardReviewsListed {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "limit must be between 1 and " + strconv.Itoa(maxRewardReviewsListed),
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	reviews, err := h.rewardService.ListRewardReviews(c.Request.Context(), c.DefaultQuery("status", services.ReviewPending), limit)
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidRewardReview) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to list reward reviews: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to fetch reward reviews",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RewardReviewListResponse{
+		Success: true,
+		Count:   len(reviews),
+		Data:    reviews,
+	})
+}
+
+// ApproveRewardReview handles POST /admin/reward-reviews/:id/approve
+func (h *RewardHandler) ApproveRewardReview(c *gin.Context) {
+	id, decision, ok := bindReviewDecision(c)
+	if !ok {
+		return
+	}
+
+	event, err := h.rewardService.ApproveRewardReview(c.Request.Context(), id, decision)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to approve reward review %d: %v", id, err)
+		if !reviewError(c, err) {
+			h.rewardError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, RewardResponse{
+		Success: true,
+		Data:    event,
+	})
+}
+
+// RejectRewardReview handles POST /admin/reward-reviews/:id/reject
+func (h *RewardHandler) RejectRewardReview(c *gin.Context) {
+	id, decision, ok := bindReviewDecision(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.rewardService.RejectRewardReview(c.Request.Context(), id, decision)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to reject reward review %d: %v", id, err)
+		if !reviewError(c, err) {
+			c.JSON(errorStatus(err), ErrorResponse{
+				Error:   "Failed to reject reward",
+				Details: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RewardReviewResponse{
+		Success: true,
+		Data:    review,
+	})
+}
+
+func bindReviewDecision(c *gin.Context) (int64, services.ReviewDecision, bool) {
+	var decision services.ReviewDecision
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "review id must be an integer",
+		})
+		return 0, decision, false
+	}
+
+	// The note is optional, so an empty body is a decision without one
+	if err := c.ShouldBindJSON(&decision); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return 0, decision, false
+	}
+	return id, decision, true
+}
+
+// reviewError writes the response for review-specific failures and reports whether it did
+func reviewError(c *gin.Context, err error) bool {
+	var status int
+	switch {
+	case errors.Is(err, services.ErrRewardReviewNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, services.ErrRewardReviewNotPending):
+		status = http.StatusConflict
+	case errors.Is(err, services.ErrInvalidRewardReview):
+		status = http.StatusBadRequest
+	default:
+		return false
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error:   "Failed to resolve reward review",
+		Details: err.Error(),
+	})
+	return true
+}
+
+type RiskHandler struct {
+	riskService services.RiskService
+	log         *logrus.Logger
+}
+
+func NewRiskHandler(riskService services.RiskService, log *logrus.Logger) *RiskHandler {
+	return &RiskHandler{
+		riskService: riskService,
+		log:         log,
+	}
+}
+
+// AddBlocklistEntry handles POST /admin/risk/blocklist
+func (h *RiskHandler) AddBlocklistEntry(c *gin.Context) {
+	var req services.BlocklistRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.riskService.AddBlocklistEntry(c.Request.Context(), &req)
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidBlocklistEntry) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to add blocklist entry: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to add blocklist entry",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, BlocklistEntryResponse{
+		Success: true,
+		Data:    entry,
+	})
+}
+
+// ListBlocklist handles GET /admin/risk/blocklist?kind=
+func (h *RiskHandler) ListBlocklist(c *gin.Context) {
+	entries, err := h.riskService.ListBlocklist(c.Request.Context(), c.Query("kind"))
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidBlocklistEntry) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to list blocklist: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to fetch blocklist",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, BlocklistResponse{
+		Success: true,
+		Count:   len(entries),
+		Data:    entries,
+	})
+}
+
+// RemoveBlocklistEntry handles DELETE /admin/risk/blocklist/:id
+func (h *RiskHandler) RemoveBlocklistEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "blocklist entry id must be an integer",
+		})
+		return
+	}
+
+	if err := h.riskService.RemoveBlocklistEntry(c.Request.Context(), id); err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrBlocklistEntryNotFound) {
+			status = http.StatusNotFound
+		}
+		requestLogger(c, h.log).Errorf("Failed to remove blocklist entry %d: %v", id, err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to remove blocklist entry",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Success: true,
+	})
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+// streamHeartbeatInterval keeps idle connections from being closed by proxies
+const streamHeartbeatInterval = 15 * time.Second
+
+type StreamHandler struct {
+	streamingService services.StreamingService
+	log              *logrus.Logger
+}
+
+func NewStreamHandler(streamingService services.StreamingService, log *logrus.Logger) *StreamHandler {
+	return &StreamHandler{
+		streamingService: streamingService,
+		log:              log,
+	}
+}
+
+// StreamPortfolio handles GET /stream/:userId as Server-Sent Events.
+// Emits `portfolio` events with the valued holdings and `price` events for held symbols.
+func (h *StreamHandler) StreamPortfolio(c *gin.Context) {
+	userID := c.Param("userId")
+
+	events, err := h.streamingService.StreamUser(c.Request.Context(), userID)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to open stream for %s: %v", userID, err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to open stream",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disable nginx response buffering
+	c.Status(http.StatusOK)
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, event.Data)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": keepalive\n\n")
+			return err == nil
+		}
+	})
+}
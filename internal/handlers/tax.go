@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type TaxHandler struct {
+	taxService services.TaxService
+	log        *logrus.Logger
+}
+
+func NewTaxHandler(taxService services.TaxService, log *logrus.Logger) *TaxHandler {
+	return &TaxHandler{
+		taxService: taxService,
+		log:        log,
+	}
+}
+
+// GetTaxReport handles GET /tax-report/:userId?fy=2024-25&format=json|csv
+func (h *TaxHandler) GetTaxReport(c *gin.Context) {
+	userID := c.Param("userId")
+
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "user_id is required",
+		})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "format must be json or csv",
+		})
+		return
+	}
+
+	report, err := h.taxService.GetTaxReport(c.Request.Context(), userID, c.Query("fy"))
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidFinancialYear) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to build tax report: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to build tax report",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if format == "csv" {
+		filename := fmt.Sprintf("tax-report-%s-FY%s.csv", userID, report.FinancialYear)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Header("Content-Type", "text/csv")
+		c.Status(http.StatusOK)
+		if err := report.WriteCSV(c.Writer); err != nil {
+			requestLogger(c, h.log).Errorf("Failed to write tax report CSV: %v", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, TaxReportResponse{
+		Success: true,
+		Data:    report,
+	})
+}
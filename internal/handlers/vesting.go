@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type VestingHandler struct {
+	vestingService services.VestingService
+	log            *logrus.Logger
+}
+
+func NewVestingHandler(vestingService services.VestingService, log *logrus.Logger) *VestingHandler {
+	return &VestingHandler{
+		vestingService: vestingService,
+		log:            log,
+	}
+}
+
+type InvalidateRewardRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// InvalidateReward handles POST /admin/rewards/:id/invalidate
+func (h *VestingHandler) InvalidateReward(c *gin.Context) {
+	rewardID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "reward id must be an integer",
+		})
+		return
+	}
+
+	var req InvalidateRewardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.vestingService.InvalidateReward(c.Request.Context(), rewardID, req.Reason)
+	if err != nil {
+		status := errorStatus(err)
+		switch {
+		case errors.Is(err, services.ErrRewardNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, services.ErrRewardAlreadyInvalidated):
+			status = http.StatusConflict
+		}
+		requestLogger(c, h.log).Errorf("Failed to invalidate reward %d: %v", rewardID, err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to invalidate reward",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, InvalidationResponse{
+		Success: true,
+		Data:    result,
+	})
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/services"
+)
+
+type WebhookHandler struct {
+	webhookService services.WebhookService
+	log            *logrus.Logger
+}
+
+func NewWebhookHandler(webhookService services.WebhookService, log *logrus.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		log:            log,
+	}
+}
+
+// CreateSubscription handles POST /webhooks/subscriptions
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	var req services.WebhookSubscriptionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	sub, err := h.webhookService.CreateSubscription(c.Request.Context(), &req)
+	if err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrInvalidWebhookSubscription) {
+			status = http.StatusBadRequest
+		}
+		requestLogger(c, h.log).Errorf("Failed to create webhook subscription: %v", err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to create webhook subscription",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// The signing secret is only ever returned here
+	c.JSON(http.StatusCreated, WebhookSubscriptionCreatedResponse{
+		Success: true,
+		Data:    sub,
+		Secret:  sub.Secret,
+	})
+}
+
+// ListSubscriptions handles GET /webhooks/subscriptions
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	subs, err := h.webhookService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to list webhook subscriptions: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch webhook subscriptions",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, WebhookSubscriptionListResponse{
+		Success: true,
+		Count:   len(subs),
+		Data:    subs,
+	})
+}
+
+// DeactivateSubscription handles DELETE /webhooks/subscriptions/:id
+func (h *WebhookHandler) DeactivateSubscription(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "subscription id must be an integer",
+		})
+		return
+	}
+
+	if err := h.webhookService.DeactivateSubscription(c.Request.Context(), id); err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrWebhookSubscriptionNotFound) {
+			status = http.StatusNotFound
+		}
+		requestLogger(c, h.log).Errorf("Failed to deactivate webhook subscription %d: %v", id, err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to deactivate webhook subscription",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Success: true,
+	})
+}
+
+// ListDeliveries handles GET /webhooks/deliveries?status=pending|delivered|dead
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	status := c.Query("status")
+	switch status {
+	case "", services.DeliveryPending, services.DeliveryDelivered, services.DeliveryDead:
+	default:
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "status must be one of pending, delivered, dead",
+		})
+		return
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request.Context(), status)
+	if err != nil {
+		requestLogger(c, h.log).Errorf("Failed to list webhook deliveries: %v", err)
+		c.JSON(errorStatus(err), ErrorResponse{
+			Error:   "Failed to fetch webhook deliveries",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, WebhookDeliveryListResponse{
+		Success: true,
+		Count:   len(deliveries),
+		Data:    deliveries,
+	})
+}
+
+// ReplayDelivery handles POST /webhooks/deliveries/:id/replay
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "delivery id must be an integer",
+		})
+		return
+	}
+
+	if err := h.webhookService.ReplayDelivery(c.Request.Context(), id); err != nil {
+		status := errorStatus(err)
+		if errors.Is(err, services.ErrWebhookDeliveryNotFound) {
+			status = http.StatusNotFound
+		}
+		requestLogger(c, h.log).Errorf("Failed to replay webhook delivery %d: %v", id, err)
+		c.JSON(status, ErrorResponse{
+			Error:   "Failed to replay webhook delivery",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, SuccessResponse{
+		Success: true,
+	})
+}
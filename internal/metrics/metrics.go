@@ -0,0 +1,290 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "stocky"
+
+// HTTP metrics
+var (
+	HTTPRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by route, method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "route", "status"},
+	)
+
+	HTTPRequestsInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "Number of HTTP requests currently being served.",
+		},
+	)
+
+	RateLimited = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "rate_limited_total",
+			Help:      "Requests rejected with 429, by route group and the key (client, user, ip) that ran out.",
+		},
+		[]string{"group", "key"},
+	)
+)
+
+// gRPC metrics
+var (
+	GRPCRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "gRPC call latency by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+
+	GRPCRateLimited = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "rate_limited_total",
+			Help:      "Calls rejected with RESOURCE_EXHAUSTED, by route group and the key (client, user, ip) that ran out.",
+		},
+		[]string{"group", "key"},
+	)
+)
+
+// Reward metrics
+var (
+	RewardsCreated = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "created_total",
+			Help:      "Number of reward events created, by stock symbol.",
+		},
+		// Reason is free text from the client, so it would add a series per distinct string
+		[]string{"symbol"},
+	)
+
+	RewardDuplicates = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "duplicates_total",
+			Help:      "Number of reward requests short-circuited by the idempotency check.",
+		},
+	)
+
+	SharesIssued = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "shares_issued_total",
+			Help:      "Fractional shares issued to users, by stock symbol.",
+		},
+		[]string{"symbol"},
+	)
+
+	RewardCostINR = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "cost_inr_total",
+			Help:      "Total INR cost (stock value plus fees) of issued rewards, by stock symbol.",
+		},
+		[]string{"symbol"},
+	)
+
+	RewardFeesINR = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "fees_inr_total",
+			Help:      "Total INR fees paid on issued rewards, by stock symbol.",
+		},
+		[]string{"symbol"},
+	)
+
+	RewardBudgetRejections = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "budget_rejections_total",
+			Help:      "Rewards rejected for exceeding a budget, by the first limit exceeded.",
+		},
+		[]string{"limit"},
+	)
+
+	RewardBudgetOverrides = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "budget_overrides_total",
+			Help:      "Rewards issued through the admin budget override.",
+		},
+	)
+
+	RewardRiskDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "risk_decisions_total",
+			Help:      "Risk decisions on new reward requests: allow, hold or deny.",
+		},
+		[]string{"decision"},
+	)
+
+	RewardReviewsResolved = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rewards",
+			Name:      "risk_reviews_resolved_total",
+			Help:      "Held rewards resolved by a reviewer, by outcome.",
+		},
+		[]string{"status"},
+	)
+)
+
+// Price updater metrics
+var (
+	PriceUpdateRuns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "price_updater",
+			Name:      "runs_total",
+			Help:      "Price updater runs by outcome (success, partial, failure).",
+		},
+		[]string{"outcome"},
+	)
+
+	PriceUpdates = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "price_updater",
+			Name:      "prices_total",
+			Help:      "Individual stock price writes by outcome (success, failure).",
+		},
+		[]string{"outcome"},
+	)
+
+	PriceUpdateLastRun = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "price_updater",
+			Name:      "last_run_timestamp_seconds",
+			Help:      "Unix timestamp of the last price updater run.",
+		},
+	)
+
+	PriceUpdateLastSuccess = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "price_updater",
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Unix timestamp of the last price updater run in which every price was written.",
+		},
+	)
+
+	PriceUpdateDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "price_updater",
+			Name:      "run_duration_seconds",
+			Help:      "Duration of price updater runs.",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+)
+
+// Webhook metrics
+var (
+	WebhookDeliveries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "webhooks",
+			Name:      "deliveries_total",
+			Help:      "Webhook delivery attempts by event type and outcome (delivered, retry, dead).",
+		},
+		[]string{"event_type", "outcome"},
+	)
+
+	WebhookDeliveryDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "webhooks",
+			Name:      "delivery_duration_seconds",
+			Help:      "Duration of outbound webhook HTTP requests.",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+)
+
+// Streaming metrics
+var (
+	StreamSubscribers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "stream",
+			Name:      "subscribers",
+			Help:      "Number of live price hub subscribers (open streaming connections).",
+		},
+	)
+
+	StreamDroppedTicks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "stream",
+			Name:      "dropped_ticks_total",
+			Help:      "Price ticks dropped because a subscriber's buffer was full.",
+		},
+	)
+)
+
+// Reconciliation metrics
+var (
+	ReconciliationMismatches = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "reconciliation",
+			Name:      "unresolved_mismatches",
+			Help:      "Holdings that disagreed with the event log and were not repaired in the last full run.",
+		},
+	)
+
+	ReconciliationRepairs = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "reconciliation",
+			Name:      "repairs_total",
+			Help:      "Holdings overwritten with values recomputed from the event log.",
+		},
+	)
+
+	ReconciliationLastRun = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "reconciliation",
+			Name:      "last_run_timestamp_seconds",
+			Help:      "Unix timestamp of the last completed full reconciliation run.",
+		},
+	)
+)
+
+// RegisterDBStats exposes connection pool statistics from sql.DB.Stats()
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+}
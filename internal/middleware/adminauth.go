@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/requestctx"
+)
+
+// AdminAuthMiddleware requires "Authorization: Bearer <token>" on admin routes, where
+// tokens maps each admin's name to their token. The matching admin becomes the
+// request's actor, so the audit log and review decisions record who acted. No tokens
+// turns the admin routes off rather than leaving them open.
+func AdminAuthMiddleware(tokens map[string]string, log *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(tokens) == 0 {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "Admin API disabled",
+				"details": "set ADMIN_API_TOKENS to enable /api/v1/admin",
+			})
+			return
+		}
+
+		scheme, credentials, _ := strings.Cut(c.GetHeader("Authorization"), " ")
+		admin, ok := "", false
+		if strings.EqualFold(scheme, "Bearer") {
+			admin, ok = matchAdminToken(tokens, strings.TrimSpace(credentials))
+		}
+		if !ok {
+			requestctx.Logger(c.Request.Context(), log).WithFields(logrus.Fields{
+				"path":      c.Request.URL.Path,
+				"client_ip": c.ClientIP(),
+			}).Warn("Admin request rejected")
+
+			c.Header("WWW-Authenticate", `Bearer realm="stocky-admin"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"details": "admin routes need a valid bearer token",
+			})
+			return
+		}
+
+		c.Request = c.Request.WithContext(requestctx.Authenticate(c.Request.Context(), admin, log))
+		c.Next()
+	}
+}
+
+// matchAdminToken returns the admin whose token is credentials. Every token is
+// compared in constant time, so the timing reveals neither a token nor which one matched.
+func matchAdminToken(tokens map[string]string, credentials string) (string, bool) {
+	admin, found := "", false
+	for name, token := range tokens {
+		if token != "" && subtle.ConstantTimeCompare([]byte(credentials), []byte(token)) == 1 {
+			admin, found = name, true
+		}
+	}
+	return admin, found
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/requestctx"
+)
+
+func newAdminRouter(tokens map[string]string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	router := gin.New()
+	router.Use(RequestContextMiddleware(log))
+	router.GET("/public", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"actor": requestctx.Actor(c.Request.Context())})
+	})
+	admin := router.Group("/admin", AdminAuthMiddleware(tokens, log))
+	admin.GET("/audit-log", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"actor": requestctx.Actor(c.Request.Context())})
+	})
+	return router
+}
+
+func actorOf(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body struct {
+		Actor string `json:"actor"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return body.Actor
+}
+
+func TestAdminAuthMiddleware(t *testing.T) {
+	router := newAdminRouter(map[string]string{"priya": "priya-secret", "ravi": "ravi-secret"})
+
+	cases := []struct {
+		auth  string
+		want  int
+		actor string
+	}{
+		{"Bearer priya-secret", http.StatusOK, "priya"},
+		{"bearer ravi-secret", http.StatusOK, "ravi"},
+		{"", http.StatusUnauthorized, ""},
+		{"Bearer", http.StatusUnauthorized, ""},
+		{"Bearer priya", http.StatusUnauthorized, ""},
+		{"Bearer priya-secret-longer", http.StatusUnauthorized, ""},
+		{"Basic priya-secret", http.StatusUnauthorized, ""},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/admin/audit-log", nil)
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		req.Header.Set("X-Actor", "mallory")
+		w := serve(router, req)
+		if w.Code != tc.want {
+			t.Errorf("Authorization %q: status %d, want %d", tc.auth, w.Code, tc.want)
+			continue
+		}
+		if tc.want == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("Authorization %q: 401 without WWW-Authenticate", tc.auth)
+		}
+		if tc.want == http.StatusOK {
+			if actor := actorOf(t, w); actor != tc.actor {
+				t.Errorf("Authorization %q: actor %q, want %q", tc.auth, actor, tc.actor)
+			}
+		}
+	}
+}
+
+func TestAdminAuthMiddlewareWithoutTokens(t *testing.T) {
+	router := newAdminRouter(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/audit-log", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	if w := serve(router, req); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status %d, want 503 when no admin token is configured", w.Code)
+	}
+}
+
+func TestUnauthenticatedRequestIgnoresActorHeader(t *testing.T) {
+	router := newAdminRouter(map[string]string{"priya": "priya-secret"})
+
+	req := httptest.NewRequest(http.MethodGet, "/public", nil)
+	req.Header.Set("X-Actor", "priya")
+	w := serve(router, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, want 200", w.Code)
+	}
+	if actor := actorOf(t, w); actor != requestctx.ActorAnonymous {
+		t.Errorf("actor %q, want %q: X-Actor must not name the caller", actor, requestctx.ActorAnonymous)
+	}
+}
@@ -1,10 +1,14 @@
 package middleware
 
 import (
+	"context"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/requestctx"
 )
 
 // LoggingMiddleware logs each HTTP request
@@ -19,7 +23,7 @@ func LoggingMiddleware(log *logrus.Logger) gin.HandlerFunc {
 		duration := time.Since(startTime)
 		statusCode := c.Writer.Status()
 		
-		log.WithFields(logrus.Fields{
+		requestctx.Logger(c.Request.Context(), log).WithFields(logrus.Fields{
 			"method":      c.Request.Method,
 			"path":        c.Request.URL.Path,
 			"status":      statusCode,
@@ -29,12 +33,52 @@ func LoggingMiddleware(log *logrus.Logger) gin.HandlerFunc {
 	}
 }
 
+// MetricsMiddleware records Prometheus latency histograms per route and status
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := time.Now()
+		metrics.HTTPRequestsInFlight.Inc()
+		defer metrics.HTTPRequestsInFlight.Dec()
+
+		c.Next()
+
+		// Use the route template so user IDs don't explode label cardinality
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		metrics.HTTPRequestDuration.WithLabelValues(
+			c.Request.Method,
+			route,
+			strconv.Itoa(c.Writer.Status()),
+		).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// TimeoutMiddleware sets a deadline on the request context so that slow
+// database work is cancelled once the request budget is spent
+func TimeoutMiddleware(timeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+		
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
 // RecoveryMiddleware recovers from panics
 func RecoveryMiddleware(log *logrus.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				log.WithFields(logrus.Fields{
+				requestctx.Logger(c.Request.Context(), log).WithFields(logrus.Fields{
 					"error": err,
 					"path":  c.Request.URL.Path,
 				}).Error("Panic recovered")
@@ -54,7 +98,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Client-ID, X-Request-ID")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "Retry-After, X-RateLimit-Limit, X-RateLimit-Remaining, X-Request-ID")
 		
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/ratelimit"
+)
+
+// HeaderClientID identifies the calling API client for rate limiting
+const HeaderClientID = "X-Client-ID"
+
+// maxUserIDPeekBytes bounds how much of a JSON body is read to find user_id
+const maxUserIDPeekBytes = 64 << 10
+
+// RateLimitMiddleware rejects requests over the policy's limits with 429 and a
+// Retry-After header. The client is the X-Client-ID header and the user is the
+// :userId path parameter or the JSON body's user_id; requests without one skip
+// that key. If the limiter fails, requests are let through.
+func RateLimitMiddleware(limiter ratelimit.Limiter, policy ratelimit.Policy, log *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		names, buckets := policy.Buckets(c.GetHeader(HeaderClientID), requestUserID(c), c.ClientIP())
+		if len(buckets) == 0 {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), buckets...)
+		if err != nil {
+			log.WithError(err).WithField("group", policy.Group).Warn("Rate limiter unavailable, allowing request")
+			c.Next()
+			return
+		}
+
+		if !result.Allowed {
+			name := names[result.Rejected]
+			metrics.RateLimited.WithLabelValues(policy.Group, name).Inc()
+			retryAfter := retryAfterSeconds(result.RetryAfter)
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.Header("X-RateLimit-Limit", strconv.Itoa(buckets[result.Rejected].Limit.PerMinute))
+			c.Header("X-RateLimit-Remaining", "0")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":   "Rate limit exceeded",
+				"details": fmt.Sprintf("too many requests per %s, retry in %s", name, time.Duration(retryAfter)*time.Second),
+			})
+			return
+		}
+
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Next()
+	}
+}
+
+// retryAfterSeconds rounds a wait up to the whole seconds of a Retry-After header,
+// never less than one
+func retryAfterSeconds(wait time.Duration) int {
+	seconds := int(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// requestUserID finds the user a request acts for: the :userId path parameter,
+// or user_id in a JSON body. The body is restored for the handler.
+func requestUserID(c *gin.Context) string {
+	if userID := c.Param("userId"); userID != "" {
+		return userID
+	}
+	if c.Request.Body == nil || !strings.HasPrefix(c.ContentType(), "application/json") {
+		return ""
+	}
+
+	peeked, err := io.ReadAll(io.LimitReader(c.Request.Body, maxUserIDPeekBytes))
+	c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(peeked), c.Request.Body), c.Request.Body}
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		UserID string `json:"user_id"`
+	}
+	if json.Unmarshal(peeked, &body) != nil {
+		return ""
+	}
+	return body.UserID
+}
+
+// readCloser replays a peeked body while still closing the original
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
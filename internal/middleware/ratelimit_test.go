@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/ratelimit"
+)
+
+func newRateLimitedRouter(policy ratelimit.Policy) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	router := gin.New()
+	router.Use(RateLimitMiddleware(ratelimit.NewMemoryLimiter(), policy, log))
+	router.GET("/portfolio/:userId", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	router.POST("/reward", func(c *gin.Context) {
+		var body struct {
+			UserID string `json:"user_id"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"user_id": body.UserID})
+	})
+	return router
+}
+
+func serve(router *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func rewardRequest(userID string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/reward", strings.NewReader(`{"user_id":"`+userID+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestRateLimitMiddlewareRejectsWith429(t *testing.T) {
+	router := newRateLimitedRouter(ratelimit.Policy{
+		Group: "read",
+		User:  ratelimit.Limit{PerMinute: 30, Burst: 2},
+	})
+
+	for i, want := range []string{"1", "0"} {
+		w := serve(router, httptest.NewRequest(http.MethodGet, "/portfolio/ravi", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status %d, want 200", i+1, w.Code)
+		}
+		if got := w.Header().Get("X-RateLimit-Remaining"); got != want {
+			t.Errorf("request %d: X-RateLimit-Remaining = %q, want %q", i+1, got, want)
+		}
+	}
+
+	w := serve(router, httptest.NewRequest(http.MethodGet, "/portfolio/ravi", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("status %d, want 429", w.Code)
+	}
+	headers := map[string]string{
+		"Retry-After":           "2", // 30 per minute refills a token every 2s
+		"X-RateLimit-Limit":     "30",
+		"X-RateLimit-Remaining": "0",
+	}
+	for name, want := range headers {
+		if got := w.Header().Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	var body struct {
+		Error   string `json:"error"`
+		Details string `json:"details"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("429 body is not JSON: %v", err)
+	}
+	if body.Error != "Rate limit exceeded" || !strings.Contains(body.Details, "per user") {
+		t.Errorf("429 body = %+v", body)
+	}
+
+	// Another user has its own bucket
+	if w := serve(router, httptest.NewRequest(http.MethodGet, "/portfolio/ankit", nil)); w.Code != http.StatusOK {
+		t.Errorf("other user: status %d, want 200", w.Code)
+	}
+}
+
+func TestRateLimitMiddlewareReadsUserFromBody(t *testing.T) {
+	router := newRateLimitedRouter(ratelimit.Policy{
+		Group: "write",
+		User:  ratelimit.Limit{PerMinute: 60, Burst: 1},
+	})
+
+	w := serve(router, rewardRequest("ravi"))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status %d, want 201: %s", w.Code, w.Body)
+	}
+	if !strings.Contains(w.Body.String(), `"user_id":"ravi"`) {
+		t.Errorf("handler did not get the peeked body: %s", w.Body)
+	}
+
+	if w := serve(router, rewardRequest("ravi")); w.Code != http.StatusTooManyRequests {
+		t.Errorf("second reward for the same user: status %d, want 429", w.Code)
+	}
+}
+
+func TestRateLimitMiddlewareRejectionKeepsOtherQuota(t *testing.T) {
+	router := newRateLimitedRouter(ratelimit.Policy{
+		Group: "write",
+		User:  ratelimit.Limit{PerMinute: 60, Burst: 1},
+		IP:    ratelimit.Limit{PerMinute: 60, Burst: 2},
+	})
+
+	if w := serve(router, rewardRequest("ravi")); w.Code != http.StatusCreated {
+		t.Fatalf("first request: status %d, want 201", w.Code)
+	}
+	for i := 0; i < 3; i++ {
+		if w := serve(router, rewardRequest("ravi")); w.Code != http.StatusTooManyRequests {
+			t.Fatalf("request over the user limit: status %d, want 429", w.Code)
+		}
+	}
+
+	// The rejected requests took nothing from the shared IP bucket
+	if w := serve(router, rewardRequest("ankit")); w.Code != http.StatusCreated {
+		t.Errorf("other user on the same IP: status %d, want 201", w.Code)
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	cases := []struct {
+		wait time.Duration
+		want int
+	}{
+		{0, 1},
+		{200 * time.Millisecond, 1},
+		{2100 * time.Millisecond, 3},
+		{10 * time.Second, 10},
+	}
+	for _, tc := range cases {
+		if got := retryAfterSeconds(tc.wait); got != tc.want {
+			t.Errorf("retryAfterSeconds(%s) = %d, want %d", tc.wait, got, tc.want)
+		}
+	}
+}
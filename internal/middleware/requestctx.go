@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/requestctx"
+)
+
+// HeaderRequestID correlates a request across logs, the audit log and the caller.
+// A valid incoming value is kept; otherwise one is generated. It is echoed in the response.
+const HeaderRequestID = "X-Request-ID"
+
+// RequestContextMiddleware puts the request ID and a log entry tagged with it (and the
+// trace ID, when traced) into the request context. The actor stays anonymous unless
+// AdminAuthMiddleware authenticates the caller.
+func RequestContextMiddleware(log *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, requestID := requestctx.Begin(c.Request.Context(), c.GetHeader(HeaderRequestID), log)
+		c.Header(HeaderRequestID, requestID)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
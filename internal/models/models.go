@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -15,37 +16,127 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// Stock represents a stock symbol
+// Stock represents an instrument. Symbol is Stocky's own key for it, which holdings
+// and rewards refer to; exchanges list it under their own tickers (see StockListing).
 type Stock struct {
 	ID          int64     `json:"id"`
 	Symbol      string    `json:"symbol"`
+	ISIN        string    `json:"isin,omitempty"` // Canonical identifier; empty for stocks added without one
 	CompanyName string    `json:"company_name"`
-	Exchange    string    `json:"exchange"`
+	Exchange    string    `json:"exchange"` // Primary listing, whose price values holdings
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// StockListing maps an exchange's ticker to an instrument's ISIN
+type StockListing struct {
+	ID          int64     `json:"id"`
+	ISIN        string    `json:"isin"`
+	Exchange    string    `json:"exchange"` // NSE or BSE
+	Symbol      string    `json:"symbol"`   // The exchange's ticker
+	StockSymbol string    `json:"stock_symbol"`
 	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 // RewardEvent represents a single reward transaction
+
 type RewardEvent struct {
-	ID              int64          `json:"id"`
-	IdempotencyKey  string         `json:"idempotency_key"`
-	UserID          string         `json:"user_id"`
-	StockSymbol     string         `json:"stock_symbol"`
-	SharesQuantity  float64        `json:"shares_quantity"`
-	PricePerShare   float64        `json:"price_per_share"`
-	TotalValue      float64        `json:"total_value"`
-	BrokerageFee    float64        `json:"brokerage_fee"`
-	STTFee          float64        `json:"stt_fee"`
-	GSTFee          float64        `json:"gst_fee"`
-	ExchangeFee     float64        `json:"exchange_fee"`
-	SEBIFee         float64        `json:"sebi_fee"`
-	TotalFees       float64        `json:"total_fees"`
-	TotalCost       float64        `json:"total_cost"`
-	Reason          string         `json:"reason"`
-	Metadata        sql.NullString `json:"metadata,omitempty"`
-	RewardedAt      time.Time      `json:"rewarded_at"`
-	CreatedAt       time.Time      `json:"created_at"`
+	ID                 int64          `json:"id"`
+	IdempotencyKey     string         `json:"idempotency_key"`
+	UserID             string         `json:"user_id"`
+	StockSymbol        string         `json:"stock_symbol"`
+	ISIN               string         `json:"isin,omitempty"`
+	Exchange           string         `json:"exchange,omitempty"` // Listing the reward was priced on
+	SharesQuantity     float64        `json:"shares_quantity"`
+	PricePerShare      float64        `json:"price_per_share"`
+	TotalValue         float64        `json:"total_value"`
+	BrokerageFee       float64        `json:"brokerage_fee"`
+	STTFee             float64        `json:"stt_fee"`
+	GSTFee             float64        `json:"gst_fee"`
+	ExchangeFee        float64        `json:"exchange_fee"`
+	SEBIFee            float64        `json:"sebi_fee"`
+	StampDutyFee       float64        `json:"stamp_duty_fee"`
+	TotalFees          float64        `json:"total_fees"`
+	TotalCost          float64        `json:"total_cost"`
+	Reason             string         `json:"reason"`
+	Metadata           sql.NullString `json:"metadata,omitempty"`
+	FeeScheduleVersion sql.NullInt64  `json:"fee_schedule_version,omitempty"`
+	RewardedAt         time.Time      `json:"rewarded_at"`
+	InvalidatedAt      sql.NullTime   `json:"invalidated_at,omitempty"`
+	InvalidationReason sql.NullString `json:"invalidation_reason,omitempty"`
+	DeviceID           sql.NullString `json:"device_id,omitempty"`
+	IPAddress          sql.NullString `json:"ip_address,omitempty"`
+	RiskDecision       string         `json:"risk_decision"`
+	RiskSignals        []RiskSignal   `json:"risk_signals"`
+	CreatedAt          time.Time      `json:"created_at"`
+
+	Vesting *VestingSchedule `json:"vesting,omitempty"` // Loaded separately, not a reward_events column
+}
+
+// RiskSignal is something a risk check found suspicious about a reward
+type RiskSignal struct {
+	Check    string `json:"check"`
+	Decision string `json:"decision"` // hold or deny
+	Detail   string `json:"detail"`
+}
+
+// RewardReview is a reward the risk checks held or denied, with its review outcome
+type RewardReview struct {
+	ID             int64           `json:"id"`
+	IdempotencyKey string          `json:"idempotency_key"`
+	UserID         string          `json:"user_id"`
+	StockSymbol    string          `json:"stock_symbol"`
+	DeviceID       sql.NullString  `json:"device_id,omitempty"`
+	IPAddress      sql.NullString  `json:"ip_address,omitempty"`
+	Metadata       sql.NullString  `json:"metadata,omitempty"`
+	Request        json.RawMessage `json:"request"`
+	Decision       string          `json:"decision"`
+	Signals        []RiskSignal    `json:"signals"`
+	Status         string          `json:"status"`
+	RewardEventID  sql.NullInt64   `json:"reward_event_id,omitempty"`
+	ReviewedBy     sql.NullString  `json:"reviewed_by,omitempty"`
+	ReviewNote     sql.NullString  `json:"review_note,omitempty"`
+	ReviewedAt     sql.NullTime    `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// BlocklistEntry denies rewards to a user, device or IP address (or CIDR range)
+type BlocklistEntry struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	Value     string    `json:"value"`
+	Reason    string    `json:"reason"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BrokerageSlab is one band of a fee schedule's brokerage rule.
+// Slabs are ordered by UpTo; the last slab must be unbounded.
+type BrokerageSlab struct {
+	UpTo   *float64 `json:"up_to,omitempty"`   // Inclusive upper bound of trade value in INR, nil = unbounded
+	Type   string   `json:"type"`              // "flat" or "percent"
+	Flat   float64  `json:"flat,omitempty"`    // INR per order for flat slabs
+	RateBP float64  `json:"rate_bp,omitempty"` // Basis points of trade value for percent slabs
+	Min    float64  `json:"min,omitempty"`     // Minimum brokerage in INR
+	Max    float64  `json:"max,omitempty"`     // Maximum brokerage in INR, 0 = uncapped
+}
+
+// FeeSchedule is an immutable, versioned set of fee rates
+type FeeSchedule struct {
+	ID             int64           `json:"id"`
+	Version        int             `json:"version"`
+	EffectiveFrom  time.Time       `json:"effective_from"`
+	BrokerageSlabs []BrokerageSlab `json:"brokerage_slabs"`
+	STTBP          float64         `json:"stt_bp"`
+	ExchangeBP     float64         `json:"exchange_bp"`
+	SEBIBP         float64         `json:"sebi_bp"`
+	StampDutyBP    float64         `json:"stamp_duty_bp"`
+	GSTPct         float64         `json:"gst_pct"`
+	Description    string          `json:"description"`
+	CreatedAt      time.Time       `json:"created_at"`
 }
 
 // UserHolding represents aggregated holdings for a user
@@ -58,26 +149,195 @@ type UserHolding struct {
 	LastUpdated  time.Time `json:"last_updated"`
 }
 
-// StockPrice represents a stock price snapshot
+// PortfolioHolding is a holding joined with its stock master row
+type PortfolioHolding struct {
+	StockSymbol  string  `json:"stock_symbol"`
+	CompanyName  string  `json:"company_name"`
+	Exchange     string  `json:"exchange"` // Primary listing; empty for a symbol missing from stocks
+	TotalShares  float64 `json:"total_shares"`
+	AveragePrice float64 `json:"average_price"`
+}
+
+// HoldingMovement is one change to a holding as recorded in the event log:
+// a reward (kind "reward"), a sale or transfer-out ("disposal"), a vesting forfeiture ("forfeit")
+// or a corporate action adjustment ("split", "merger_out", "merger_in")
+type HoldingMovement struct {
+	UserID        string    `json:"user_id"`
+	StockSymbol   string    `json:"stock_symbol"`
+	Kind          string    `json:"kind"`
+	Quantity      float64   `json:"quantity"`
+	PricePerShare float64   `json:"price_per_share"` // Rewards and merger_in only
+	ShareFactor   float64   `json:"share_factor"`    // Splits only
+	At            time.Time `json:"at"`
+	SourceID      int64     `json:"source_id"`
+}
+
+// ReconciliationRun records one pass of the holdings reconciliation job
+type ReconciliationRun struct {
+	ID              string         `json:"id"`
+	Scope           sql.NullString `json:"scope,omitempty"`
+	Repair          bool           `json:"repair"`
+	HoldingsChecked int            `json:"holdings_checked"`
+	Mismatches      int            `json:"mismatches"`
+	Repaired        int            `json:"repaired"`
+	StartedAt       time.Time      `json:"started_at"`
+	FinishedAt      sql.NullTime   `json:"finished_at,omitempty"`
+}
+
+// HoldingReconciliationAudit is the before/after of a holding repaired by reconciliation
+type HoldingReconciliationAudit struct {
+	ID                   int64           `json:"id"`
+	RunID                string          `json:"run_id"`
+	UserID               string          `json:"user_id"`
+	StockSymbol          string          `json:"stock_symbol"`
+	PreviousShares       sql.NullFloat64 `json:"previous_shares,omitempty"`
+	PreviousAveragePrice sql.NullFloat64 `json:"previous_average_price,omitempty"`
+	RepairedShares       float64         `json:"repaired_shares"`
+	RepairedAveragePrice float64         `json:"repaired_average_price"`
+	CreatedAt            time.Time       `json:"created_at"`
+}
+
+// VestingTranche is a quantity that vests at a point in time
+type VestingTranche struct {
+	VestAt   time.Time `json:"vest_at"`
+	Quantity float64   `json:"quantity"`
+}
+
+// VestingSchedule locks some or all of a reward's shares until they vest
+type VestingSchedule struct {
+	ID                int64            `json:"id"`
+	RewardEventID     int64            `json:"reward_event_id"`
+	UserID            string           `json:"user_id"`
+	StockSymbol       string           `json:"stock_symbol"`
+	ScheduleType      string           `json:"schedule_type"`
+	TotalQuantity     float64          `json:"total_quantity"`
+	StartAt           time.Time        `json:"start_at"`
+	CliffAt           sql.NullTime     `json:"cliff_at,omitempty"`
+	EndAt             sql.NullTime     `json:"end_at,omitempty"`
+	Tranches          []VestingTranche `json:"tranches,omitempty"`
+	Status            string           `json:"status"`
+	ForfeitedQuantity float64          `json:"forfeited_quantity"`
+	ForfeitedAt       sql.NullTime     `json:"forfeited_at,omitempty"`
+	ForfeitReason     sql.NullString   `json:"forfeit_reason,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+}
+
+// TaxLot is the acquisition lot created by a single reward
+type TaxLot struct {
+	ID                int64     `json:"id"`
+	RewardEventID     int64     `json:"reward_event_id"`
+	UserID            string    `json:"user_id"`
+	StockSymbol       string    `json:"stock_symbol"`
+	AcquiredAt        time.Time `json:"acquired_at"`
+	Quantity          float64   `json:"quantity"`
+	RemainingQuantity float64   `json:"remaining_quantity"`
+	CostPerShare      float64   `json:"cost_per_share"`
+	CreatedAt         time.Time `json:"created_at"`
+	ForfeitedQuantity float64   `json:"forfeited_quantity,omitempty"` // Unvested shares clawed back; only set by GetLotsAcquiredBetween
+}
+
+// LotDisposal records the part of a tax lot consumed by a disposal
+type LotDisposal struct {
+	ID               int64         `json:"id"`
+	TaxLotID         int64         `json:"tax_lot_id"`
+	DisposalID       sql.NullInt64 `json:"disposal_id,omitempty"`
+	UserID           string        `json:"user_id"`
+	StockSymbol      string        `json:"stock_symbol"`
+	Quantity         float64       `json:"quantity"`
+	AcquiredAt       time.Time     `json:"acquired_at"`
+	DisposedAt       time.Time     `json:"disposed_at"`
+	CostPerShare     float64       `json:"cost_per_share"`
+	ProceedsPerShare float64       `json:"proceeds_per_share"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+// Disposal is a user-initiated sale or transfer-out of rewarded shares
+type Disposal struct {
+	ID                 int64          `json:"id"`
+	IdempotencyKey     string         `json:"idempotency_key"`
+	UserID             string         `json:"user_id"`
+	StockSymbol        string         `json:"stock_symbol"`
+	DisposalType       string         `json:"disposal_type"`
+	Quantity           float64        `json:"quantity"`
+	PricePerShare      float64        `json:"price_per_share"`
+	GrossProceeds      float64        `json:"gross_proceeds"`
+	BrokerageFee       float64        `json:"brokerage_fee"`
+	STTFee             float64        `json:"stt_fee"`
+	GSTFee             float64        `json:"gst_fee"`
+	ExchangeFee        float64        `json:"exchange_fee"`
+	SEBIFee            float64        `json:"sebi_fee"`
+	TotalFees          float64        `json:"total_fees"`
+	NetProceeds        float64        `json:"net_proceeds"`
+	DematAccount       sql.NullString `json:"demat_account,omitempty"`
+	FeeScheduleVersion sql.NullInt64  `json:"fee_schedule_version,omitempty"`
+	DisposedAt         time.Time      `json:"disposed_at"`
+	CreatedAt          time.Time      `json:"created_at"`
+}
+
+// WebhookSubscription is a downstream endpoint that receives signed event payloads
+type WebhookSubscription struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	URL        string    `json:"url"`
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types"`
+	IsActive   bool      `json:"is_active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// OutboxEvent is a domain event recorded in the same transaction as its change
+type OutboxEvent struct {
+	ID            int64        `json:"id"`
+	EventID       string       `json:"event_id"`
+	EventType     string       `json:"event_type"`
+	AggregateType string       `json:"aggregate_type"`
+	AggregateID   string       `json:"aggregate_id"`
+	Payload       []byte       `json:"-"`
+	CreatedAt     time.Time    `json:"created_at"`
+	DispatchedAt  sql.NullTime `json:"dispatched_at,omitempty"`
+}
+
+// WebhookDelivery tracks delivery of one outbox event to one subscription
+type WebhookDelivery struct {
+	ID             int64          `json:"id"`
+	OutboxEventID  int64          `json:"outbox_event_id"`
+	SubscriptionID int64          `json:"subscription_id"`
+	EventType      string         `json:"event_type"`
+	Status         string         `json:"status"`
+	Attempts       int            `json:"attempts"`
+	NextAttemptAt  time.Time      `json:"next_attempt_at"`
+	LastStatusCode sql.NullInt64  `json:"last_status_code,omitempty"`
+	LastError      sql.NullString `json:"last_error,omitempty"`
+	DeliveredAt    sql.NullTime   `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// StockPrice represents a price snapshot of one listing of a stock
 type StockPrice struct {
 	ID          int64     `json:"id"`
 	StockSymbol string    `json:"stock_symbol"`
+	Exchange    string    `json:"exchange"`
 	Price       float64   `json:"price"`
 	Timestamp   time.Time `json:"timestamp"`
 	Source      string    `json:"source"`
 }
 
 // LedgerEntry represents a double-entry accounting record
+
 type LedgerEntry struct {
-	ID             int64          `json:"id"`
-	EntryGroupID   string         `json:"entry_group_id"`
-	RewardEventID  sql.NullInt64  `json:"reward_event_id,omitempty"`
-	AccountType    string         `json:"account_type"`
-	StockSymbol    sql.NullString `json:"stock_symbol,omitempty"`
-	DebitAmount    float64        `json:"debit_amount"`
-	CreditAmount   float64        `json:"credit_amount"`
-	Description    string         `json:"description"`
-	CreatedAt      time.Time      `json:"created_at"`
+	ID                int64          `json:"id"`
+	EntryGroupID      string         `json:"entry_group_id"`
+	RewardEventID     sql.NullInt64  `json:"reward_event_id,omitempty"`
+	DisposalID        sql.NullInt64  `json:"disposal_id,omitempty"`
+	VestingScheduleID sql.NullInt64  `json:"vesting_schedule_id,omitempty"`
+	AccountType       string         `json:"account_type"`
+	StockSymbol       sql.NullString `json:"stock_symbol,omitempty"`
+	DebitAmount       float64        `json:"debit_amount"`
+	CreditAmount      float64        `json:"credit_amount"`
+	Description       string         `json:"description"`
+	CreatedAt         time.Time      `json:"created_at"`
 }
 
 // StockEvent represents corporate actions like splits, mergers
@@ -96,15 +356,91 @@ type StockEvent struct {
 	CreatedAt          time.Time      `json:"created_at"`
 }
 
+// CorporateActionAdjustment is the change a corporate action made to one holding
+type CorporateActionAdjustment struct {
+	ID                   int64     `json:"id"`
+	StockEventID         int64     `json:"stock_event_id"`
+	UserID               string    `json:"user_id"`
+	StockSymbol          string    `json:"stock_symbol"`
+	Kind                 string    `json:"kind"`
+	ShareFactor          float64   `json:"share_factor"`
+	Quantity             float64   `json:"quantity"`
+	PricePerShare        float64   `json:"price_per_share"`
+	PreviousShares       float64   `json:"previous_shares"`
+	PreviousAveragePrice float64   `json:"previous_average_price"`
+	NewShares            float64   `json:"new_shares"`
+	NewAveragePrice      float64   `json:"new_average_price"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+// RewardSpend is the total_cost of rewards created so far in each budget window
+type RewardSpend struct {
+	UserDaily   float64
+	UserMonthly float64
+	ReasonDaily float64
+	GlobalDaily float64
+}
+
+// BudgetBreach describes one reward budget a reward would exceed
+type BudgetBreach struct {
+	Limit        string  `json:"limit"` // per_reward, user_daily, user_monthly, reason_daily or global_daily
+	Reason       string  `json:"reason,omitempty"`
+	LimitINR     float64 `json:"limit_inr"`
+	SpentINR     float64 `json:"spent_inr"`
+	RequestedINR float64 `json:"requested_inr"`
+}
+
+// RewardBudgetOverride records an admin-approved reward that exceeded its budgets
+type RewardBudgetOverride struct {
+	ID            int64          `json:"id"`
+	RewardEventID int64          `json:"reward_event_id"`
+	UserID        string         `json:"user_id"`
+	ApprovedBy    string         `json:"approved_by"`
+	Justification string         `json:"justification"`
+	Breaches      []BudgetBreach `json:"breaches"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
 // Portfolio represents a user's complete portfolio
+
 type PortfolioItem struct {
-	StockSymbol  string  `json:"stock_symbol"`
-	CompanyName  string  `json:"company_name"`
-	TotalShares  float64 `json:"total_shares"`
-	AveragePrice float64 `json:"average_price"`
-	CurrentPrice float64 `json:"current_price"`
-	CurrentValue float64 `json:"current_value"`
-	TotalCost    float64 `json:"total_cost"`
-	ProfitLoss   float64 `json:"profit_loss"`
-	ProfitLossPct float64 `json:"profit_loss_pct"`
+	StockSymbol    string  `json:"stock_symbol"`
+	CompanyName    string  `json:"company_name"`
+	Exchange       string  `json:"exchange,omitempty"` // Listing whose price values the holding
+	TotalShares    float64 `json:"total_shares"`
+	AveragePrice   float64 `json:"average_price"`
+	CurrentPrice   float64 `json:"current_price"`
+	CurrentValue   float64 `json:"current_value"`
+	TotalCost      float64 `json:"total_cost"`
+	ProfitLoss     float64 `json:"profit_loss"`
+	ProfitLossPct  float64 `json:"profit_loss_pct"`
+	VestedShares   float64 `json:"vested_shares"`
+	UnvestedShares float64 `json:"unvested_shares"`
+}
+
+// AuditEntry is one row of the append-only, hash-chained audit log
+type AuditEntry struct {
+	ID         int64           `json:"id"`
+	Actor      string          `json:"actor"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestID  sql.NullString  `json:"request_id,omitempty"`
+	PrevHash   string          `json:"prev_hash"`
+	Hash       string          `json:"hash"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditFilter selects audit log rows; zero fields match everything
+type AuditFilter struct {
+	EntityType string
+	EntityID   string
+	Actor      string
+	Action     string
+	Since      time.Time
+	Until      time.Time
+	AfterID    int64 // Keyset pagination: only rows with a greater ID
+	Limit      int
 }
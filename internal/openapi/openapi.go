@@ -0,0 +1,264 @@
+// Package openapi builds an OpenAPI 3 document from a route table and the Go types
+// the handlers bind and return, so the spec cannot drift from the JSON on the wire.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds a path's operations keyed by lower-case HTTP method
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // path, query or header
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// String, DateTime and Integer are parameter schemas
+func String(enum ...string) *Schema { return &Schema{Type: "string", Enum: enum} }
+func DateTime() *Schema             { return &Schema{Type: "string", Format: "date-time"} }
+func Integer() *Schema              { return &Schema{Type: "integer", Format: "int64"} }
+
+// Route describes one endpoint. Path uses gin syntax; its :params become path
+// parameters, typed integer when named id and string otherwise.
+type Route struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Description string
+	Tag         string
+	Query       []Parameter
+	Body        interface{} // Zero value of the JSON request body type, nil for none
+	Replies     []Reply
+}
+
+// Reply is one possible response. Body is a zero value of the JSON type returned;
+// replies that share a status are alternative content types of one response.
+type Reply struct {
+	Status      int
+	Description string
+	Body        interface{}
+	ContentType string // Defaults to application/json
+}
+
+// Build returns the document for the routes, with every type they reference
+// under components/schemas
+func Build(info Info, servers []Server, tags []Tag, routes []Route) (*Document, error) {
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       info,
+		Servers:    servers,
+		Tags:       tags,
+		Paths:      map[string]*PathItem{},
+		Components: Components{Schemas: map[string]*Schema{}},
+	}
+	schemas := newSchemaBuilder(doc.Components.Schemas)
+	operationIDs := map[string]bool{}
+
+	for _, route := range routes {
+		method := strings.ToLower(route.Method)
+		path, pathParams := convertPath(route.Path)
+
+		item := doc.Paths[path]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		if (*item)[method] != nil {
+			return nil, fmt.Errorf("%s %s is described twice", route.Method, route.Path)
+		}
+		if route.OperationID == "" || operationIDs[route.OperationID] {
+			return nil, fmt.Errorf("%s %s needs a unique operation id, got %q", route.Method, route.Path, route.OperationID)
+		}
+		operationIDs[route.OperationID] = true
+
+		op := &Operation{
+			OperationID: route.OperationID,
+			Summary:     route.Summary,
+			Description: route.Description,
+			Responses:   map[string]Response{},
+		}
+		if route.Tag != "" {
+			op.Tags = []string{route.Tag}
+		}
+		op.Parameters = append(pathParams, route.Query...)
+
+		if route.Body != nil {
+			schema, err := schemas.schemaFor(route.Body)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s request body: %w", route.Method, route.Path, err)
+			}
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content:  map[string]MediaType{gin.MIMEJSON: {Schema: schema}},
+			}
+		}
+
+		for _, reply := range route.Replies {
+			schema, err := replySchema(schemas, reply)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s %d response: %w", route.Method, route.Path, reply.Status, err)
+			}
+			contentType := reply.ContentType
+			if contentType == "" {
+				contentType = gin.MIMEJSON
+			}
+
+			status := strconv.Itoa(reply.Status)
+			response, ok := op.Responses[status]
+			if !ok {
+				description := reply.Description
+				if description == "" {
+					description = http.StatusText(reply.Status)
+				}
+				response = Response{Description: description, Content: map[string]MediaType{}}
+			}
+			response.Content[contentType] = MediaType{Schema: schema}
+			op.Responses[status] = response
+		}
+		if len(op.Responses) == 0 {
+			return nil, fmt.Errorf("%s %s has no responses", route.Method, route.Path)
+		}
+
+		(*item)[method] = op
+	}
+	return doc, nil
+}
+
+func replySchema(schemas *schemaBuilder, reply Reply) (*Schema, error) {
+	if reply.Body != nil {
+		return schemas.schemaFor(reply.Body)
+	}
+	if reply.ContentType == "" || reply.ContentType == gin.MIMEJSON {
+		return &Schema{Type: "object"}, nil
+	}
+	return &Schema{Type: "string"}, nil
+}
+
+// convertPath turns /users/:userId into /users/{userId} and returns its parameters
+func convertPath(path string) (string, []Parameter) {
+	segments := strings.Split(path, "/")
+	var params []Parameter
+	for i, segment := range segments {
+		if segment == "" || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		name := segment[1:]
+		segments[i] = "{" + name + "}"
+
+		schema := String()
+		if name == "id" {
+			schema = Integer()
+		}
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// CheckRoutes compares the document with the routes registered on a router and
+// describes every route missing from the spec and every spec path no route serves
+func CheckRoutes(doc *Document, routes gin.RoutesInfo) error {
+	served := map[string]bool{}
+	var problems []string
+	for _, route := range routes {
+		path, _ := convertPath(route.Path)
+		key := route.Method + " " + path
+		served[key] = true
+
+		if item := doc.Paths[path]; item == nil || (*item)[strings.ToLower(route.Method)] == nil {
+			problems = append(problems, key+" is not in the spec")
+		}
+	}
+	for path, item := range doc.Paths {
+		for method := range *item {
+			key := strings.ToUpper(method) + " " + path
+			if !served[key] {
+				problems = append(problems, key+" is in the spec but not routed")
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("OpenAPI spec is out of sync with the router: %s", strings.Join(problems, "; "))
+}
@@ -0,0 +1,196 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaBuilder derives schemas from Go types the way encoding/json marshals them.
+// Named structs become components referenced by $ref.
+type schemaBuilder struct {
+	components map[string]*Schema
+	types      map[string]reflect.Type // Component name to the type that claimed it
+}
+
+func newSchemaBuilder(components map[string]*Schema) *schemaBuilder {
+	return &schemaBuilder{components: components, types: map[string]reflect.Type{}}
+}
+
+func (b *schemaBuilder) schemaFor(value interface{}) (*Schema, error) {
+	return b.schema(reflect.TypeOf(value))
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) (*Schema, error) {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case rawMessageType:
+		return &Schema{}, nil // Any JSON value
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.schema(t.Elem())
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		// A nil slice marshals as null
+		return &Schema{Type: "array", Items: items, Nullable: t.Kind() == reflect.Slice}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key of %s must be a string", t)
+		}
+		values, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values, Nullable: true}, nil
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		return b.component(t)
+	}
+	return nil, fmt.Errorf("no schema for %s", t)
+}
+
+// component registers a named struct once and returns a reference to it
+func (b *schemaBuilder) component(t reflect.Type) (*Schema, error) {
+	name := t.Name()
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+
+	if claimed, ok := b.types[name]; ok {
+		if claimed != t {
+			return nil, fmt.Errorf("schema name %s is used by both %s and %s", name, claimed, t)
+		}
+		return ref, nil
+	}
+	b.types[name] = t // Claim before building, so recursive types terminate
+
+	schema, err := b.object(t)
+	if err != nil {
+		return nil, err
+	}
+	b.components[name] = schema
+	return ref, nil
+}
+
+// object builds a struct's properties. Embedded structs are flattened, as
+// encoding/json does. A struct with binding tags is a request: only fields bound
+// as required are required. Otherwise every field that is always marshalled is.
+func (b *schemaBuilder) object(t reflect.Type) (*Schema, error) {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	fields, err := b.fields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	isRequest := false
+	for _, f := range fields {
+		if _, ok := f.field.Tag.Lookup("binding"); ok {
+			isRequest = true
+			break
+		}
+	}
+
+	for _, f := range fields {
+		schema.Properties[f.name] = f.schema
+		if (isRequest && f.bindingRequired()) || (!isRequest && !f.omitEmpty && f.field.Type.Kind() != reflect.Ptr) {
+			schema.Required = append(schema.Required, f.name)
+		}
+	}
+	return schema, nil
+}
+
+type field struct {
+	field     reflect.StructField
+	name      string
+	omitEmpty bool
+	schema    *Schema
+}
+
+func (f field) bindingRules() []string {
+	return strings.Split(f.field.Tag.Get("binding"), ",")
+}
+
+func (f field) bindingRequired() bool {
+	for _, rule := range f.bindingRules() {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *schemaBuilder) fields(t reflect.Type) ([]field, error) {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if sf.Anonymous && !hasTag {
+			embedded := sf.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner, err := b.fields(embedded)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, inner...)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		schema, err := b.schema(sf.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t, sf.Name, err)
+		}
+		f := field{field: sf, name: name, omitEmpty: strings.Contains(","+options+",", ",omitempty,"), schema: schema}
+		for _, rule := range f.bindingRules() {
+			if values, ok := strings.CutPrefix(rule, "oneof="); ok && schema.Ref == "" {
+				schema.Enum = strings.Fields(values)
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
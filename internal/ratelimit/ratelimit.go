@@ -0,0 +1,196 @@
+// Package ratelimit implements token-bucket rate limiting behind an interface,
+// so the in-process limiter can be swapped for a shared store when several
+// instances serve traffic.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: PerMinute tokens are added each minute, up to Burst
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+// Enabled reports whether the limit restricts anything
+func (l Limit) Enabled() bool {
+	return l.PerMinute > 0
+}
+
+// capacity is the bucket size; a zero burst allows one request at a time
+func (l Limit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return 1
+}
+
+// ratePerSecond is how fast the bucket refills
+func (l Limit) ratePerSecond() float64 {
+	return float64(l.PerMinute) / 60
+}
+
+// Bucket names one token bucket a request needs a token from
+type Bucket struct {
+	Key   string
+	Limit Limit
+}
+
+// Policy limits one route group. Each key has its own bucket; a request must get
+// a token from every enabled one. A zero Limit disables that key.
+type Policy struct {
+	Group  string
+	User   Limit
+	IP     Limit
+	Client Limit
+}
+
+// Buckets returns the buckets a request from client, user and ip takes a token
+// from, with the key name of each. Empty values and disabled limits are skipped.
+// The REST and gRPC APIs build their buckets here, so they share quota.
+func (p Policy) Buckets(client, user, ip string) (names []string, buckets []Bucket) {
+	keys := []struct {
+		name  string
+		value string
+		limit Limit
+	}{
+		{"client", client, p.Client},
+		{"user", user, p.User},
+		{"ip", ip, p.IP},
+	}
+	for _, key := range keys {
+		if key.value == "" || !key.limit.Enabled() {
+			continue
+		}
+		names = append(names, key.name)
+		buckets = append(buckets, Bucket{
+			Key:   fmt.Sprintf("%s:%s:%s", p.Group, key.name, key.value),
+			Limit: key.limit,
+		})
+	}
+	return names, buckets
+}
+
+// Result is the outcome of one Allow call
+type Result struct {
+	Allowed    bool
+	Remaining  int           // Whole tokens left in the emptiest bucket after this request
+	RetryAfter time.Duration // When every bucket will next have a token; zero if Allowed
+	Rejected   int           // Index of the bucket that rejected the request; -1 if Allowed
+}
+
+// Limiter takes one token from every bucket, or from none of them when any bucket
+// is empty, so a rejected request never drains quota. Buckets with a disabled
+// Limit are ignored. Implementations must be safe for concurrent use. A shared
+// implementation (Redis, Postgres) keeps the same bucket semantics so limits
+// hold across instances.
+type Limiter interface {
+	Allow(ctx context.Context, buckets ...Bucket) (Result, error)
+}
+
+// sweepInterval is how often idle buckets are dropped from a memory limiter
+const sweepInterval = time.Minute
+
+// tokenEpsilon absorbs float error in refills, so a client that waits exactly
+// RetryAfter finds its token
+const tokenEpsilon = 1e-9
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   Limit
+}
+
+type memoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryLimiter returns a Limiter that keeps buckets in process memory.
+// Limits apply per instance.
+func NewMemoryLimiter() Limiter {
+	return &memoryLimiter{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (m *memoryLimiter) Allow(ctx context.Context, buckets ...Bucket) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	// Check every bucket before taking any token
+	result := Result{Allowed: true, Remaining: math.MaxInt32, Rejected: -1}
+	held := make([]*bucket, 0, len(buckets))
+	for i, request := range buckets {
+		if !request.Limit.Enabled() {
+			continue
+		}
+
+		b, ok := m.buckets[request.Key]
+		if !ok {
+			b = &bucket{tokens: request.Limit.capacity(), updated: now}
+			m.buckets[request.Key] = b
+		}
+		b.limit = request.Limit
+		b.refill(now)
+
+		if b.tokens+tokenEpsilon < 1 {
+			wait := time.Duration(math.Ceil((1 - b.tokens) / request.Limit.ratePerSecond() * float64(time.Second)))
+			if result.Allowed || wait > result.RetryAfter {
+				result.RetryAfter = wait
+				result.Rejected = i
+			}
+			result.Allowed = false
+			result.Remaining = 0
+			continue
+		}
+		held = append(held, b)
+	}
+	if !result.Allowed {
+		return result, nil
+	}
+
+	for _, b := range held {
+		b.tokens = math.Max(0, b.tokens-1)
+		if int(b.tokens) < result.Remaining {
+			result.Remaining = int(b.tokens)
+		}
+	}
+	return result, nil
+}
+
+// refill adds the tokens earned since the last request, up to capacity
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.limit.capacity(), b.tokens+elapsed*b.limit.ratePerSecond())
+		b.updated = now
+	}
+}
+
+// sweep drops buckets that have refilled completely; a new bucket starts full,
+// so forgetting them changes nothing
+func (m *memoryLimiter) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		b.refill(now)
+		if b.tokens >= b.limit.capacity() {
+			delete(m.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeClock drives a memory limiter's notion of now
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter() (*memoryLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 22, 10, 0, 0, 0, time.UTC)}
+	limiter := NewMemoryLimiter().(*memoryLimiter)
+	limiter.now = func() time.Time { return clock.now }
+	limiter.lastSweep = clock.now
+	return limiter, clock
+}
+
+func allow(t *testing.T, limiter Limiter, buckets ...Bucket) Result {
+	t.Helper()
+	result, err := limiter.Allow(context.Background(), buckets...)
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	return result
+}
+
+func TestMemoryLimiterBurst(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	bucket := Bucket{Key: "user:ravi", Limit: Limit{PerMinute: 60, Burst: 3}}
+
+	for want := 2; want >= 0; want-- {
+		result := allow(t, limiter, bucket)
+		if !result.Allowed {
+			t.Fatalf("request with %d tokens left was rejected", want+1)
+		}
+		if result.Remaining != want {
+			t.Errorf("Remaining = %d, want %d", result.Remaining, want)
+		}
+	}
+
+	result := allow(t, limiter, bucket)
+	if result.Allowed {
+		t.Fatal("request past the burst was allowed")
+	}
+	if result.Rejected != 0 {
+		t.Errorf("Rejected = %d, want 0", result.Rejected)
+	}
+}
+
+func TestMemoryLimiterZeroBurstAllowsOne(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	bucket := Bucket{Key: "ip:10.0.0.1", Limit: Limit{PerMinute: 60}}
+
+	if !allow(t, limiter, bucket).Allowed {
+		t.Fatal("first request was rejected")
+	}
+	if allow(t, limiter, bucket).Allowed {
+		t.Fatal("second request was allowed with no burst")
+	}
+}
+
+func TestMemoryLimiterRefill(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	bucket := Bucket{Key: "user:ravi", Limit: Limit{PerMinute: 60, Burst: 2}}
+
+	allow(t, limiter, bucket)
+	allow(t, limiter, bucket)
+	if allow(t, limiter, bucket).Allowed {
+		t.Fatal("empty bucket allowed a request")
+	}
+
+	clock.advance(500 * time.Millisecond)
+	if allow(t, limiter, bucket).Allowed {
+		t.Fatal("half a token allowed a request")
+	}
+
+	clock.advance(500 * time.Millisecond)
+	if !allow(t, limiter, bucket).Allowed {
+		t.Fatal("refilled token was not available")
+	}
+
+	// A long idle period refills only up to the burst
+	clock.advance(time.Hour)
+	for i := 0; i < 2; i++ {
+		if !allow(t, limiter, bucket).Allowed {
+			t.Fatalf("request %d after idling was rejected", i+1)
+		}
+	}
+	if allow(t, limiter, bucket).Allowed {
+		t.Fatal("bucket refilled past its burst")
+	}
+}
+
+func TestMemoryLimiterRetryAfter(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	bucket := Bucket{Key: "client:payouts", Limit: Limit{PerMinute: 6, Burst: 1}}
+
+	allow(t, limiter, bucket)
+	result := allow(t, limiter, bucket)
+	if result.Allowed {
+		t.Fatal("empty bucket allowed a request")
+	}
+	if result.RetryAfter != 10*time.Second {
+		t.Errorf("RetryAfter = %s, want 10s", result.RetryAfter)
+	}
+
+	clock.advance(4 * time.Second)
+	result = allow(t, limiter, bucket)
+	if result.RetryAfter != 6*time.Second {
+		t.Errorf("RetryAfter after 4s = %s, want 6s", result.RetryAfter)
+	}
+
+	clock.advance(result.RetryAfter)
+	if !allow(t, limiter, bucket).Allowed {
+		t.Error("request after RetryAfter was rejected")
+	}
+}
+
+func TestMemoryLimiterRejectionTakesNoTokens(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	ip := Bucket{Key: "ip:10.0.0.1", Limit: Limit{PerMinute: 60, Burst: 2}}
+	user := Bucket{Key: "user:ravi", Limit: Limit{PerMinute: 60, Burst: 1}}
+
+	allow(t, limiter, ip, user)
+	for i := 0; i < 5; i++ {
+		result := allow(t, limiter, ip, user)
+		if result.Allowed {
+			t.Fatal("request over the user limit was allowed")
+		}
+		if result.Rejected != 1 {
+			t.Errorf("Rejected = %d, want the user bucket", result.Rejected)
+		}
+	}
+
+	// The IP bucket still holds the token the rejected requests did not take
+	result := allow(t, limiter, ip)
+	if !result.Allowed || result.Remaining != 0 {
+		t.Errorf("IP bucket after rejections: allowed %t, remaining %d; want allowed with 0 left", result.Allowed, result.Remaining)
+	}
+}
+
+func TestMemoryLimiterRetryAfterWaitsForEveryBucket(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	fast := Bucket{Key: "ip:10.0.0.1", Limit: Limit{PerMinute: 60, Burst: 1}}
+	slow := Bucket{Key: "user:ravi", Limit: Limit{PerMinute: 6, Burst: 1}}
+
+	allow(t, limiter, fast, slow)
+	result := allow(t, limiter, fast, slow)
+	if result.Allowed {
+		t.Fatal("request with both buckets empty was allowed")
+	}
+	if result.Rejected != 1 || result.RetryAfter != 10*time.Second {
+		t.Errorf("Rejected = %d, RetryAfter = %s; want the slow bucket and 10s", result.Rejected, result.RetryAfter)
+	}
+}
+
+func TestMemoryLimiterDisabledLimit(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	bucket := Bucket{Key: "user:ravi", Limit: Limit{}}
+
+	for i := 0; i < 100; i++ {
+		if !allow(t, limiter, bucket).Allowed {
+			t.Fatal("disabled limit rejected a request")
+		}
+	}
+	if len(limiter.buckets) != 0 {
+		t.Errorf("disabled limit created %d buckets", len(limiter.buckets))
+	}
+}
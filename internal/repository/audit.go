@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// AuditRepository appends to and reads the hash-chained audit log
+type AuditRepository interface {
+	LockChain(ctx context.Context) error
+	GetLastHash(ctx context.Context) (string, error)
+	CreateEntry(ctx context.Context, entry *models.AuditEntry) error
+	ListEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+	WithTx(tx *sql.Tx) AuditRepository
+}
+
+type auditRepository struct {
+	db DBTX
+}
+
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *auditRepository) WithTx(tx *sql.Tx) AuditRepository {
+	return &auditRepository{db: tx}
+}
+
+// LockChain serializes appends so each entry links to the one committed before it.
+// It must run inside a transaction; the lock is released on commit or rollback.
+func (r *auditRepository) LockChain(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('audit_log'))`)
+	return err
+}
+
+// GetLastHash returns the hash of the newest entry, or "" if the log is empty
+func (r *auditRepository) GetLastHash(ctx context.Context) (string, error) {
+	var hash string
+	err := r.db.QueryRowContext(ctx, `SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1`).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return hash, err
+}
+
+func (r *auditRepository) CreateEntry(ctx context.Context, entry *models.AuditEntry) error {
+	query := `
+		INSERT INTO audit_log (
+			actor, action, entity_type, entity_id, before_state, after_state,
+			request_id, prev_hash, hash, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+	return r.db.QueryRowContext(ctx, query,
+		entry.Actor, entry.Action, entry.EntityType, entry.EntityID,
+		nullJSON(entry.Before), nullJSON(entry.After),
+		entry.RequestID, entry.PrevHash, entry.Hash, entry.CreatedAt,
+	).Scan(&entry.ID)
+}
+
+// ListEntries returns matching entries in chain order (oldest first)
+func (r *auditRepository) ListEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	var where []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.EntityType != "" {
+		add("entity_type = $%d", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		add("entity_id = $%d", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		add("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		add("action = $%d", filter.Action)
+	}
+	if !filter.Since.IsZero() {
+		add("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		add("created_at < $%d", filter.Until)
+	}
+	if filter.AfterID > 0 {
+		add("id > $%d", filter.AfterID)
+	}
+
+	query := `
+		SELECT id, actor, action, entity_type, entity_id, before_state, after_state,
+			   request_id, prev_hash, hash, created_at
+		FROM audit_log`
+	if len(where) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(where, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf("\n\t\tORDER BY id\n\t\tLIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []models.AuditEntry
+	for rows.Next() {
+		var e models.AuditEntry
+		var before, after []byte
+		if err := rows.Scan(
+			&e.ID, &e.Actor, &e.Action, &e.EntityType, &e.EntityID, &before, &after,
+			&e.RequestID, &e.PrevHash, &e.Hash, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.Before, e.After = before, after
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// nullJSON stores an absent state as SQL NULL rather than the text "null"
+func nullJSON(raw []byte) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
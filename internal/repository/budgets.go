@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// BudgetRepository measures reward spend and records budget overrides
+type BudgetRepository interface {
+	LockBudget(ctx context.Context, key string) error
+	GetRewardSpend(ctx context.Context, userID, reason string, dayStart, monthStart time.Time) (*models.RewardSpend, error)
+	CreateOverride(ctx context.Context, override *models.RewardBudgetOverride) error
+	ListOverrides(ctx context.Context, userID string, limit int) ([]models.RewardBudgetOverride, error)
+	WithTx(tx *sql.Tx) BudgetRepository
+}
+
+type budgetRepository struct {
+	db DBTX
+}
+
+func NewBudgetRepository(db *sql.DB) BudgetRepository {
+	return &budgetRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *budgetRepository) WithTx(tx *sql.Tx) BudgetRepository {
+	return &budgetRepository{db: tx}
+}
+
+// LockBudget takes a transaction-scoped advisory lock on key, serializing the
+// spend check and insert of concurrent rewards charged to the same budget.
+// It must run inside a transaction; the lock is released on commit or rollback.
+func (r *budgetRepository) LockBudget(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, "reward_budget:"+key)
+	return err
+}
+
+// GetRewardSpend sums total_cost of rewards by created_at, less the value of shares
+// forfeited when a reward was invalidated. Shares the user kept stay spent. The
+// window starts are UTC, like created_at.
+func (r *budgetRepository) GetRewardSpend(ctx context.Context, userID, reason string, dayStart, monthStart time.Time) (*models.RewardSpend, error) {
+	query := `
+		WITH spend AS (
+			SELECT r.user_id, r.reason, r.created_at,
+				   r.total_cost - COALESCE(vs.forfeited_quantity, 0) * r.price_per_share AS cost
+			FROM reward_events r
+			LEFT JOIN vesting_schedules vs ON vs.reward_event_id = r.id
+			WHERE r.created_at >= LEAST($3::timestamp, $4::timestamp)
+		)
+		SELECT
+			COALESCE(SUM(cost) FILTER (WHERE user_id = $1 AND created_at >= $3), 0),
+			COALESCE(SUM(cost) FILTER (WHERE user_id = $1 AND created_at >= $4), 0),
+			COALESCE(SUM(cost) FILTER (WHERE reason = $2 AND created_at >= $3), 0),
+			COALESCE(SUM(cost) FILTER (WHERE created_at >= $3), 0)
+		FROM spend
+	`
+
+	spend := &models.RewardSpend{}
+	err := r.db.QueryRowContext(ctx, query, userID, reason, dayStart, monthStart).Scan(
+		&spend.UserDaily, &spend.UserMonthly, &spend.ReasonDaily, &spend.GlobalDaily,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return spend, nil
+}
+
+func (r *budgetRepository) CreateOverride(ctx context.Context, override *models.RewardBudgetOverride) error {
+	breaches, err := json.Marshal(override.Breaches)
+	if err != nil {
+		return fmt.Errorf("failed to encode breaches: %w", err)
+	}
+
+	query := `
+		INSERT INTO reward_budget_overrides (reward_event_id, user_id, approved_by, justification, breaches)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(ctx, query,
+		override.RewardEventID, override.UserID, override.ApprovedBy, override.Justification, breaches,
+	).Scan(&override.ID, &override.CreatedAt)
+}
+
+// ListOverrides returns the newest overrides first; an empty userID matches all users
+func (r *budgetRepository) ListOverrides(ctx context.Context, userID string, limit int) ([]models.RewardBudgetOverride, error) {
+	query := `
+		SELECT id, reward_event_id, user_id, approved_by, justification, breaches, created_at
+		FROM reward_budget_overrides
+		WHERE $1 = '' OR user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := []models.RewardBudgetOverride{}
+	for rows.Next() {
+		var o models.RewardBudgetOverride
+		var breaches []byte
+		if err := rows.Scan(&o.ID, &o.RewardEventID, &o.UserID, &o.ApprovedBy, &o.Justification, &breaches, &o.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(breaches, &o.Breaches); err != nil {
+			return nil, fmt.Errorf("invalid breaches in budget override %d: %w", o.ID, err)
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides, rows.Err()
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// CorporateActionRepository reads stock_events and applies them to holdings, tax lots and vesting schedules
+type CorporateActionRepository interface {
+	CreateStockEvent(ctx context.Context, event *models.StockEvent) error
+	ListStockEvents(ctx context.Context, pendingOnly bool) ([]models.StockEvent, error)
+	GetStockEventForUpdate(ctx context.Context, id int64) (*models.StockEvent, error)
+	MarkProcessed(ctx context.Context, id int64) error
+	GetHoldingsForUpdate(ctx context.Context, stockSymbol string) ([]models.UserHolding, error)
+	CreateAdjustment(ctx context.Context, adjustment *models.CorporateActionAdjustment) error
+	ScaleTaxLots(ctx context.Context, fromSymbol, toSymbol string, factor float64) (int64, error)
+	ScaleVestingSchedules(ctx context.Context, stockSymbol string, factor float64) (int64, error)
+	SetStockActive(ctx context.Context, stockSymbol string, active bool) error
+	WithTx(tx *sql.Tx) CorporateActionRepository
+}
+
+type corporateActionRepository struct {
+	db DBTX
+}
+
+func NewCorporateActionRepository(db *sql.DB) CorporateActionRepository {
+	return &corporateActionRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *corporateActionRepository) WithTx(tx *sql.Tx) CorporateActionRepository {
+	return &corporateActionRepository{db: tx}
+}
+
+const stockEventColumns = `id, stock_symbol, event_type, event_date, split_ratio_old, split_ratio_new,
+			   merged_into_symbol, conversion_ratio, COALESCE(description, ''), processed,
+			   processed_at, created_at`
+
+func scanStockEvent(row rowScanner) (*models.StockEvent, error) {
+	event := &models.StockEvent{}
+	err := row.Scan(
+		&event.ID, &event.StockSymbol, &event.EventType, &event.EventDate, &event.SplitRatioOld, &event.SplitRatioNew,
+		&event.MergedIntoSymbol, &event.ConversionRatio, &event.Description, &event.Processed,
+		&event.ProcessedAt, &event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (r *corporateActionRepository) CreateStockEvent(ctx context.Context, event *models.StockEvent) error {
+	query := `
+		INSERT INTO stock_events (
+			stock_symbol, event_type, event_date, split_ratio_old, split_ratio_new,
+			merged_into_symbol, conversion_ratio, description
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, processed, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		event.StockSymbol, event.EventType, event.EventDate, event.SplitRatioOld, event.SplitRatioNew,
+		event.MergedIntoSymbol, event.ConversionRatio, event.Description,
+	).Scan(&event.ID, &event.Processed, &event.CreatedAt)
+}
+
+// ListStockEvents returns events in the order they should be applied
+func (r *corporateActionRepository) ListStockEvents(ctx context.Context, pendingOnly bool) ([]models.StockEvent, error) {
+	query := `
+		SELECT ` + stockEventColumns + `
+		FROM stock_events
+		WHERE NOT $1 OR processed = FALSE
+		ORDER BY event_date, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pendingOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.StockEvent
+	for rows.Next() {
+		event, err := scanStockEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, rows.Err()
+}
+
+// GetStockEventForUpdate locks the event so it is applied at most once.
+// Must be called on a repository bound with WithTx.
+func (r *corporateActionRepository) GetStockEventForUpdate(ctx context.Context, id int64) (*models.StockEvent, error) {
+	query := `
+		SELECT ` + stockEventColumns + `
+		FROM stock_events
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	event, err := scanStockEvent(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return event, err
+}
+
+func (r *corporateActionRepository) MarkProcessed(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE stock_events SET processed = TRUE, processed_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+// GetHoldingsForUpdate locks every non-empty holding of a stock, in a stable order
+func (r *corporateActionRepository) GetHoldingsForUpdate(ctx context.Context, stockSymbol string) ([]models.UserHolding, error) {
+	query := `
+		SELECT id, user_id, stock_symbol, total_shares, average_price, last_updated
+		FROM user_holdings
+		WHERE stock_symbol = $1 AND total_shares > 0
+		ORDER BY user_id
+		FOR UPDATE
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, stockSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holdings []models.UserHolding
+	for rows.Next() {
+		var holding models.UserHolding
+		if err := rows.Scan(
+			&holding.ID, &holding.UserID, &holding.StockSymbol,
+			&holding.TotalShares, &holding.AveragePrice, &holding.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, holding)
+	}
+
+	return holdings, rows.Err()
+}
+
+func (r *corporateActionRepository) CreateAdjustment(ctx context.Context, adjustment *models.CorporateActionAdjustment) error {
+	query := `
+		INSERT INTO corporate_action_adjustments (
+			stock_event_id, user_id, stock_symbol, kind, share_factor, quantity, price_per_share,
+			previous_shares, previous_average_price, new_shares, new_average_price
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		adjustment.StockEventID, adjustment.UserID, adjustment.StockSymbol, adjustment.Kind,
+		adjustment.ShareFactor, adjustment.Quantity, adjustment.PricePerShare,
+		adjustment.PreviousShares, adjustment.PreviousAveragePrice, adjustment.NewShares, adjustment.NewAveragePrice,
+	).Scan(&adjustment.ID, &adjustment.CreatedAt)
+}
+
+// ScaleTaxLots multiplies open lot quantities by factor and divides their cost basis by it,
+// moving them to toSymbol. Fully consumed lots keep their historical values.
+func (r *corporateActionRepository) ScaleTaxLots(ctx context.Context, fromSymbol, toSymbol string, factor float64) (int64, error) {
+	query := `
+		UPDATE tax_lots
+		SET stock_symbol = $2,
+			quantity = ROUND(quantity * $3, 6),
+			remaining_quantity = ROUND(remaining_quantity * $3, 6),
+			cost_per_share = ROUND(cost_per_share / $3, 4)
+		WHERE stock_symbol = $1 AND remaining_quantity > 0
+	`
+
+	result, err := r.db.ExecContext(ctx, query, fromSymbol, toSymbol, factor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ScaleVestingSchedules multiplies the quantities of active schedules, including each tranche
+func (r *corporateActionRepository) ScaleVestingSchedules(ctx context.Context, stockSymbol string, factor float64) (int64, error) {
+	query := `
+		UPDATE vesting_schedules
+		SET total_quantity = ROUND(total_quantity * $2, 6),
+			tranches = CASE WHEN tranches IS NULL THEN NULL ELSE (
+				SELECT jsonb_agg(
+					jsonb_set(t.tranche, '{quantity}', to_jsonb(ROUND((t.tranche->>'quantity')::numeric * $2, 6)))
+					ORDER BY t.ordinality
+				)
+				FROM jsonb_array_elements(tranches) WITH ORDINALITY AS t(tranche, ordinality)
+			) END
+		WHERE stock_symbol = $1 AND status = 'active'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, stockSymbol, factor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (r *corporateActionRepository) SetStockActive(ctx context.Context, stockSymbol string, active bool) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE stocks SET is_active = $2, updated_at = NOW() WHERE symbol = $1`, stockSymbol, active)
+	return err
+}
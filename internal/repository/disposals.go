@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// DisposalRepository handles user sales and transfers of rewarded shares
+type DisposalRepository interface {
+	CreateDisposal(ctx context.Context, disposal *models.Disposal) error
+	GetDisposalByIdempotencyKey(ctx context.Context, key string) (*models.Disposal, error)
+	GetUserDisposals(ctx context.Context, userID string) ([]models.Disposal, error)
+	WithTx(tx *sql.Tx) DisposalRepository
+}
+
+type disposalRepository struct {
+	db      DBTX
+	replica DBTX // the disposal history read; the primary when no replica is configured
+}
+
+func NewDisposalRepository(db *sql.DB) DisposalRepository {
+	return &disposalRepository{db: db, replica: db}
+}
+
+// NewDisposalRepositoryWithReplica sends the disposal history read to replica
+func NewDisposalRepositoryWithReplica(db, replica *sql.DB) DisposalRepository {
+	return &disposalRepository{db: db, replica: replica}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction.
+// Every query, including reads, then runs inside it.
+func (r *disposalRepository) WithTx(tx *sql.Tx) DisposalRepository {
+	return &disposalRepository{db: tx, replica: tx}
+}
+
+const disposalColumns = `id, idempotency_key, user_id, stock_symbol, disposal_type, quantity,
+			   price_per_share, gross_proceeds, brokerage_fee, stt_fee, gst_fee,
+			   exchange_fee, sebi_fee, total_fees, net_proceeds, demat_account,
+			   fee_schedule_version, disposed_at, created_at`
+
+func scanDisposal(row rowScanner) (*models.Disposal, error) {
+	d := &models.Disposal{}
+	err := row.Scan(
+		&d.ID, &d.IdempotencyKey, &d.UserID, &d.StockSymbol, &d.DisposalType, &d.Quantity,
+		&d.PricePerShare, &d.GrossProceeds, &d.BrokerageFee, &d.STTFee, &d.GSTFee,
+		&d.ExchangeFee, &d.SEBIFee, &d.TotalFees, &d.NetProceeds, &d.DematAccount,
+		&d.FeeScheduleVersion, &d.DisposedAt, &d.CreatedAt,
+	)
+	return d, err
+}
+
+func (r *disposalRepository) CreateDisposal(ctx context.Context, d *models.Disposal) error {
+	query := `
+		INSERT INTO disposals (
+			idempotency_key, user_id, stock_symbol, disposal_type, quantity,
+			price_per_share, gross_proceeds, brokerage_fee, stt_fee, gst_fee,
+			exchange_fee, sebi_fee, total_fees, net_proceeds, demat_account,
+			fee_schedule_version, disposed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		d.IdempotencyKey, d.UserID, d.StockSymbol, d.DisposalType, d.Quantity,
+		d.PricePerShare, d.GrossProceeds, d.BrokerageFee, d.STTFee, d.GSTFee,
+		d.ExchangeFee, d.SEBIFee, d.TotalFees, d.NetProceeds, d.DematAccount,
+		d.FeeScheduleVersion, d.DisposedAt,
+	).Scan(&d.ID, &d.CreatedAt)
+}
+
+func (r *disposalRepository) GetDisposalByIdempotencyKey(ctx context.Context, key string) (*models.Disposal, error) {
+	query := `
+		SELECT ` + disposalColumns + `
+		FROM disposals
+		WHERE idempotency_key = $1
+	`
+
+	d, err := scanDisposal(r.db.QueryRowContext(ctx, query, key))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return d, err
+}
+
+func (r *disposalRepository) GetUserDisposals(ctx context.Context, userID string) ([]models.Disposal, error) {
+	query := `
+		SELECT ` + disposalColumns + `
+		FROM disposals
+		WHERE user_id = $1
+		ORDER BY disposed_at DESC
+	`
+
+	rows, err := r.replica.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var disposals []models.Disposal
+	for rows.Next() {
+		d, err := scanDisposal(rows)
+		if err != nil {
+			return nil, err
+		}
+		disposals = append(disposals, *d)
+	}
+
+	return disposals, rows.Err()
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// FeeScheduleRepository handles versioned fee schedules
+type FeeScheduleRepository interface {
+	GetEffectiveFeeSchedule(ctx context.Context, at time.Time) (*models.FeeSchedule, error)
+	ListFeeSchedules(ctx context.Context) ([]models.FeeSchedule, error)
+	CreateFeeSchedule(ctx context.Context, schedule *models.FeeSchedule) error
+	WithTx(tx *sql.Tx) FeeScheduleRepository
+}
+
+type feeScheduleRepository struct {
+	db DBTX
+}
+
+func NewFeeScheduleRepository(db *sql.DB) FeeScheduleRepository {
+	return &feeScheduleRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *feeScheduleRepository) WithTx(tx *sql.Tx) FeeScheduleRepository {
+	return &feeScheduleRepository{db: tx}
+}
+
+const feeScheduleColumns = `id, version, effective_from, brokerage_slabs, stt_bp, exchange_bp,
+			   sebi_bp, stamp_duty_bp, gst_pct, COALESCE(description, ''), created_at`
+
+func scanFeeSchedule(row rowScanner) (*models.FeeSchedule, error) {
+	schedule := &models.FeeSchedule{}
+	var slabs []byte
+	err := row.Scan(
+		&schedule.ID, &schedule.Version, &schedule.EffectiveFrom, &slabs,
+		&schedule.STTBP, &schedule.ExchangeBP, &schedule.SEBIBP,
+		&schedule.StampDutyBP, &schedule.GSTPct, &schedule.Description, &schedule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(slabs, &schedule.BrokerageSlabs); err != nil {
+		return nil, fmt.Errorf("invalid brokerage slabs in fee schedule v%d: %w", schedule.Version, err)
+	}
+	return schedule, nil
+}
+
+// GetEffectiveFeeSchedule returns the newest schedule in force at the given
+// time, or nil if none has taken effect yet
+func (r *feeScheduleRepository) GetEffectiveFeeSchedule(ctx context.Context, at time.Time) (*models.FeeSchedule, error) {
+	query := `
+		SELECT ` + feeScheduleColumns + `
+		FROM fee_schedules
+		WHERE effective_from <= $1
+		ORDER BY effective_from DESC, version DESC
+		LIMIT 1
+	`
+
+	schedule, err := scanFeeSchedule(r.db.QueryRowContext(ctx, query, at))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return schedule, err
+}
+
+func (r *feeScheduleRepository) ListFeeSchedules(ctx context.Context) ([]models.FeeSchedule, error) {
+	query := `
+		SELECT ` + feeScheduleColumns + `
+		FROM fee_schedules
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []models.FeeSchedule
+	for rows.Next() {
+		schedule, err := scanFeeSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+// CreateFeeSchedule inserts a new schedule with the next version number.
+// Existing versions are never updated so past rewards stay reproducible.
+// Must be called on a repository bound with WithTx.
+func (r *feeScheduleRepository) CreateFeeSchedule(ctx context.Context, schedule *models.FeeSchedule) error {
+	slabs, err := json.Marshal(schedule.BrokerageSlabs)
+	if err != nil {
+		return fmt.Errorf("failed to encode brokerage slabs: %w", err)
+	}
+
+	// Serialise version allocation between concurrent writers
+	if _, err := r.db.ExecContext(ctx, `LOCK TABLE fee_schedules IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO fee_schedules (
+			version, effective_from, brokerage_slabs, stt_bp, exchange_bp,
+			sebi_bp, stamp_duty_bp, gst_pct, description
+		)
+		SELECT COALESCE(MAX(version), 0) + 1, $1, $2, $3, $4, $5, $6, $7, $8
+		FROM fee_schedules
+		RETURNING id, version, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		schedule.EffectiveFrom, slabs, schedule.STTBP, schedule.ExchangeBP,
+		schedule.SEBIBP, schedule.StampDutyBP, schedule.GSTPct, schedule.Description,
+	).Scan(&schedule.ID, &schedule.Version, &schedule.CreatedAt)
+}
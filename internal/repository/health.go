@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrNoReplica is returned by replica checks when no read replica is configured
+var ErrNoReplica = errors.New("no read replica configured")
+
+// HealthRepository exposes the database signals used by readiness checks
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	GetMigrationVersion(ctx context.Context) (int, error)
+	GetLatestPriceTimestamp(ctx context.Context) (*time.Time, error)
+	PingReplica(ctx context.Context) error
+	GetReplicationLag(ctx context.Context) (time.Duration, error)
+}
+
+type healthRepository struct {
+	db      *sql.DB
+	replica *sql.DB // nil without a read replica
+}
+
+func NewHealthRepository(db *sql.DB) HealthRepository {
+	return &healthRepository{db: db}
+}
+
+// NewHealthRepositoryWithReplica also checks the read replica
+func NewHealthRepositoryWithReplica(db, replica *sql.DB) HealthRepository {
+	return &healthRepository{db: db, replica: replica}
+}
+
+func (r *healthRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+func (r *healthRepository) PingReplica(ctx context.Context) error {
+	if r.replica == nil {
+		return ErrNoReplica
+	}
+	return r.replica.PingContext(ctx)
+}
+
+// GetReplicationLag returns how far the replica's replay is behind. It is zero
+// when the replica is idle and up to date, since the replay timestamp only
+// advances with new transactions.
+func (r *healthRepository) GetReplicationLag(ctx context.Context) (time.Duration, error) {
+	if r.replica == nil {
+		return 0, ErrNoReplica
+	}
+	var seconds sql.NullFloat64
+	err := r.replica.QueryRowContext(ctx, `
+		SELECT CASE
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE EXTRACT(EPOCH FROM NOW() - pg_last_xact_replay_timestamp())
+		END
+	`).Scan(&seconds)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds.Float64 * float64(time.Second)), nil
+}
+
+func (r *healthRepository) GetMigrationVersion(ctx context.Context) (int, error) {
+	var version int
+	err := r.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	return version, err
+}
+
+func (r *healthRepository) GetLatestPriceTimestamp(ctx context.Context) (*time.Time, error) {
+	var latest sql.NullTime
+	if err := r.db.QueryRowContext(ctx, `SELECT MAX(timestamp) FROM latest_stock_prices`).Scan(&latest); err != nil {
+		return nil, err
+	}
+	if !latest.Valid {
+		return nil, nil
+	}
+	return &latest.Time, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PriceRetentionRepository maintains stock_prices partitions and rolls old ticks up into daily OHLC rows
+type PriceRetentionRepository interface {
+	EnsurePartitions(ctx context.Context, from, to time.Time) (int, error)
+	ListPartitions(ctx context.Context) ([]string, error)
+	DownsampleBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	DropPartition(ctx context.Context, name string) error
+	DeleteTicksBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	WithTx(tx *sql.Tx) PriceRetentionRepository
+}
+
+type priceRetentionRepository struct {
+	db DBTX
+}
+
+func NewPriceRetentionRepository(db *sql.DB) PriceRetentionRepository {
+	return &priceRetentionRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *priceRetentionRepository) WithTx(tx *sql.Tx) PriceRetentionRepository {
+	return &priceRetentionRepository{db: tx}
+}
+
+// EnsurePartitions creates any missing monthly partitions covering [from, to]
+func (r *priceRetentionRepository) EnsurePartitions(ctx context.Context, from, to time.Time) (int, error) {
+	var created int
+	err := r.db.QueryRowContext(ctx, `SELECT ensure_stock_price_partitions($1, $2)`, from, to).Scan(&created)
+	return created, err
+}
+
+// ListPartitions returns the names of all stock_prices partitions, including the default one
+func (r *priceRetentionRepository) ListPartitions(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'stock_prices'::regclass
+		ORDER BY c.relname
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+// DownsampleBefore rolls ticks older than cutoff into stock_prices_daily. A day that
+// was rolled up before is merged, so late ticks widen its range instead of replacing it.
+func (r *priceRetentionRepository) DownsampleBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		INSERT INTO stock_prices_daily (
+			stock_symbol, exchange, trade_date, open, high, low, close, tick_count, open_at, close_at
+		)
+		SELECT stock_symbol,
+			   exchange,
+			   timestamp::date,
+			   (array_agg(price ORDER BY timestamp ASC))[1],
+			   MAX(price),
+			   MIN(price),
+			   (array_agg(price ORDER BY timestamp DESC))[1],
+			   COUNT(*),
+			   MIN(timestamp),
+			   MAX(timestamp)
+		FROM stock_prices
+		WHERE timestamp < $1
+		GROUP BY stock_symbol, exchange, timestamp::date
+		ON CONFLICT (stock_symbol, exchange, trade_date) DO UPDATE
+		SET open = CASE WHEN EXCLUDED.open_at < stock_prices_daily.open_at
+						THEN EXCLUDED.open ELSE stock_prices_daily.open END,
+			open_at = LEAST(EXCLUDED.open_at, stock_prices_daily.open_at),
+			close = CASE WHEN EXCLUDED.close_at > stock_prices_daily.close_at
+						 THEN EXCLUDED.close ELSE stock_prices_daily.close END,
+			close_at = GREATEST(EXCLUDED.close_at, stock_prices_daily.close_at),
+			high = GREATEST(EXCLUDED.high, stock_prices_daily.high),
+			low = LEAST(EXCLUDED.low, stock_prices_daily.low),
+			tick_count = stock_prices_daily.tick_count + EXCLUDED.tick_count,
+			updated_at = NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// DropPartition drops a whole partition, which is far cheaper than deleting its rows
+func (r *priceRetentionRepository) DropPartition(ctx context.Context, name string) error {
+	_, err := r.db.ExecContext(ctx, `DROP TABLE IF EXISTS `+pq.QuoteIdentifier(name))
+	return err
+}
+
+// DeleteTicksBefore removes raw ticks older than cutoff from partitions that are only partly expired
+func (r *priceRetentionRepository) DeleteTicksBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM stock_prices WHERE timestamp < $1`, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
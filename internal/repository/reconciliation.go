@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// ReconciliationRepository reads the event log behind user_holdings and records reconciliation runs
+type ReconciliationRepository interface {
+	GetHoldingMovements(ctx context.Context, userID, stockSymbol string) ([]models.HoldingMovement, error)
+	GetHoldings(ctx context.Context, userID string) ([]models.UserHolding, error)
+	CreateRun(ctx context.Context, run *models.ReconciliationRun) error
+	FinishRun(ctx context.Context, run *models.ReconciliationRun) error
+	CreateAudit(ctx context.Context, audit *models.HoldingReconciliationAudit) error
+	WithTx(tx *sql.Tx) ReconciliationRepository
+}
+
+type reconciliationRepository struct {
+	db DBTX
+}
+
+func NewReconciliationRepository(db *sql.DB) ReconciliationRepository {
+	return &reconciliationRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *reconciliationRepository) WithTx(tx *sql.Tx) ReconciliationRepository {
+	return &reconciliationRepository{db: tx}
+}
+
+// GetHoldingMovements returns every reward, disposal, forfeiture and corporate action
+// adjustment in the order it was applied to user_holdings, grouped by user and symbol.
+// Empty filters match all.
+func (r *reconciliationRepository) GetHoldingMovements(ctx context.Context, userID, stockSymbol string) ([]models.HoldingMovement, error) {
+	query := `
+		SELECT user_id, stock_symbol, kind, quantity, price_per_share, share_factor, at, source_id
+		FROM (
+			SELECT user_id, stock_symbol, 'reward' AS kind, shares_quantity AS quantity,
+				   price_per_share, 1::numeric AS share_factor, created_at AS at, id AS source_id
+			FROM reward_events
+			UNION ALL
+			SELECT user_id, stock_symbol, 'disposal', quantity, 0, 1, created_at, id
+			FROM disposals
+			UNION ALL
+			SELECT user_id, stock_symbol, 'forfeit', forfeited_quantity, 0, 1, forfeited_at, id
+			FROM vesting_schedules
+			WHERE status = 'forfeited' AND forfeited_quantity > 0
+			UNION ALL
+			SELECT user_id, stock_symbol, kind, quantity, price_per_share, share_factor, created_at, id
+			FROM corporate_action_adjustments
+		) movements
+		WHERE ($1 = '' OR user_id = $1) AND ($2 = '' OR stock_symbol = $2)
+		ORDER BY user_id, stock_symbol, at, source_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, stockSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []models.HoldingMovement
+	for rows.Next() {
+		var m models.HoldingMovement
+		if err := rows.Scan(
+			&m.UserID, &m.StockSymbol, &m.Kind, &m.Quantity,
+			&m.PricePerShare, &m.ShareFactor, &m.At, &m.SourceID,
+		); err != nil {
+			return nil, err
+		}
+		movements = append(movements, m)
+	}
+
+	return movements, rows.Err()
+}
+
+// GetHoldings returns holding rows, including empty ones. An empty userID matches all users.
+func (r *reconciliationRepository) GetHoldings(ctx context.Context, userID string) ([]models.UserHolding, error) {
+	query := `
+		SELECT id, user_id, stock_symbol, total_shares, average_price, last_updated
+		FROM user_holdings
+		WHERE ($1 = '' OR user_id = $1)
+		ORDER BY user_id, stock_symbol
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holdings []models.UserHolding
+	for rows.Next() {
+		var holding models.UserHolding
+		if err := rows.Scan(
+			&holding.ID, &holding.UserID, &holding.StockSymbol,
+			&holding.TotalShares, &holding.AveragePrice, &holding.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, holding)
+	}
+
+	return holdings, rows.Err()
+}
+
+func (r *reconciliationRepository) CreateRun(ctx context.Context, run *models.ReconciliationRun) error {
+	query := `
+		INSERT INTO reconciliation_runs (id, scope, repair, started_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, run.ID, run.Scope, run.Repair, run.StartedAt)
+	return err
+}
+
+func (r *reconciliationRepository) FinishRun(ctx context.Context, run *models.ReconciliationRun) error {
+	query := `
+		UPDATE reconciliation_runs
+		SET holdings_checked = $2, mismatches = $3, repaired = $4, finished_at = $5
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		run.ID, run.HoldingsChecked, run.Mismatches, run.Repaired, run.FinishedAt,
+	)
+	return err
+}
+
+func (r *reconciliationRepository) CreateAudit(ctx context.Context, audit *models.HoldingReconciliationAudit) error {
+	query := `
+		INSERT INTO holding_reconciliation_audit (
+			run_id, user_id, stock_symbol, previous_shares, previous_average_price,
+			repaired_shares, repaired_average_price
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		audit.RunID, audit.UserID, audit.StockSymbol, audit.PreviousShares, audit.PreviousAveragePrice,
+		audit.RepairedShares, audit.RepairedAveragePrice,
+	).Scan(&audit.ID, &audit.CreatedAt)
+}
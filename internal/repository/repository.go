@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,61 +11,128 @@ import (
 )
 
 type RewardRepository interface {
-	CreateRewardEvent(event *models.RewardEvent) error
-	GetRewardEventByIdempotencyKey(key string) (*models.RewardEvent, error)
-	GetTodayRewards(userID string) ([]models.RewardEvent, error)
-	GetHistoricalRewards(userID string) ([]models.RewardEvent, error)
-	GetUserHolding(userID, stockSymbol string) (*models.UserHolding, error)
-	UpsertUserHolding(holding *models.UserHolding) error
-	GetUserPortfolio(userID string) ([]models.UserHolding, error)
+	CreateRewardEvent(ctx context.Context, event *models.RewardEvent) error
+	GetRewardEventByIdempotencyKey(ctx context.Context, key string) (*models.RewardEvent, error)
+	GetRewardEventByIDForUpdate(ctx context.Context, id int64) (*models.RewardEvent, error)
+	InvalidateRewardEvent(ctx context.Context, id int64, reason string) error
+	GetTodayRewards(ctx context.Context, userID string) ([]models.RewardEvent, error)
+	GetHistoricalRewards(ctx context.Context, userID string) ([]models.RewardEvent, error)
+	GetUserHoldingForUpdate(ctx context.Context, userID, stockSymbol string) (*models.UserHolding, error)
+	UpsertUserHolding(ctx context.Context, holding *models.UserHolding) error
+	ApplyRewardToHolding(ctx context.Context, event *models.RewardEvent) error
+	DecrementUserHolding(ctx context.Context, userID, stockSymbol string, quantity float64) error
+	GetUserPortfolio(ctx context.Context, userID string) ([]models.PortfolioHolding, error)
+	WithTx(tx *sql.Tx) RewardRepository
 }
 
 type rewardRepository struct {
-	db *sql.DB
+	db      DBTX
+	replica DBTX // the reward history and portfolio reads; the primary when no replica is configured
 }
 
 func NewRewardRepository(db *sql.DB) RewardRepository {
-	return &rewardRepository{db: db}
+	return &rewardRepository{db: db, replica: db}
 }
 
-func (r *rewardRepository) CreateRewardEvent(event *models.RewardEvent) error {
+// NewRewardRepositoryWithReplica sends the reward history and portfolio reads to replica
+func NewRewardRepositoryWithReplica(db, replica *sql.DB) RewardRepository {
+	return &rewardRepository{db: db, replica: replica}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction.
+// Every query, including reads, then runs inside it.
+func (r *rewardRepository) WithTx(tx *sql.Tx) RewardRepository {
+	return &rewardRepository{db: tx, replica: tx}
+}
+
+func (r *rewardRepository) CreateRewardEvent(ctx context.Context, event *models.RewardEvent) error {
 	query := `
 		INSERT INTO reward_events (
 			idempotency_key, user_id, stock_symbol, shares_quantity, 
 			price_per_share, total_value, brokerage_fee, stt_fee, 
-			gst_fee, exchange_fee, sebi_fee, total_fees, total_cost,
-			reason, metadata, rewarded_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			gst_fee, exchange_fee, sebi_fee, stamp_duty_fee, total_fees, total_cost,
+			reason, metadata, fee_schedule_version, rewarded_at,
+			device_id, ip_address, risk_decision, risk_signals, isin, exchange
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22,
+			NULLIF($23, ''), NULLIF($24, ''))
 		RETURNING id, created_at
 	`
 
-	return r.db.QueryRow(
+	signals, err := marshalRiskSignals(event.RiskSignals)
+	if err != nil {
+		return err
+	}
+
+	return r.db.QueryRowContext(
+		ctx,
 		query,
 		event.IdempotencyKey, event.UserID, event.StockSymbol, event.SharesQuantity,
 		event.PricePerShare, event.TotalValue, event.BrokerageFee, event.STTFee,
-		event.GSTFee, event.ExchangeFee, event.SEBIFee, event.TotalFees, event.TotalCost,
-		event.Reason, event.Metadata, event.RewardedAt,
+		event.GSTFee, event.ExchangeFee, event.SEBIFee, event.StampDutyFee, event.TotalFees, event.TotalCost,
+		event.Reason, event.Metadata, event.FeeScheduleVersion, event.RewardedAt,
+		event.DeviceID, event.IPAddress, event.RiskDecision, signals, event.ISIN, event.Exchange,
 	).Scan(&event.ID, &event.CreatedAt)
 }
 
-func (r *rewardRepository) GetRewardEventByIdempotencyKey(key string) (*models.RewardEvent, error) {
-	query := `
-		SELECT id, idempotency_key, user_id, stock_symbol, shares_quantity,
+// rewardEventColumns is the column list matching scanRewardEvent
+const rewardEventColumns = `id, idempotency_key, user_id, stock_symbol, shares_quantity,
 			   price_per_share, total_value, brokerage_fee, stt_fee, gst_fee,
-			   exchange_fee, sebi_fee, total_fees, total_cost, reason, metadata,
-			   rewarded_at, created_at
-		FROM reward_events
-		WHERE idempotency_key = $1
-	`
+			   exchange_fee, sebi_fee, stamp_duty_fee, total_fees, total_cost, reason, metadata,
+			   fee_schedule_version, rewarded_at, invalidated_at, invalidation_reason,
+			   device_id, ip_address, risk_decision, risk_signals, created_at,
+			   COALESCE(isin, ''), COALESCE(exchange, '')`
 
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRewardEvent(row rowScanner) (*models.RewardEvent, error) {
 	event := &models.RewardEvent{}
-	err := r.db.QueryRow(query, key).Scan(
+	var signals []byte
+	err := row.Scan(
 		&event.ID, &event.IdempotencyKey, &event.UserID, &event.StockSymbol,
 		&event.SharesQuantity, &event.PricePerShare, &event.TotalValue,
 		&event.BrokerageFee, &event.STTFee, &event.GSTFee, &event.ExchangeFee,
-		&event.SEBIFee, &event.TotalFees, &event.TotalCost, &event.Reason,
-		&event.Metadata, &event.RewardedAt, &event.CreatedAt,
+		&event.SEBIFee, &event.StampDutyFee, &event.TotalFees, &event.TotalCost, &event.Reason,
+		&event.Metadata, &event.FeeScheduleVersion, &event.RewardedAt,
+		&event.InvalidatedAt, &event.InvalidationReason,
+		&event.DeviceID, &event.IPAddress, &event.RiskDecision, &signals, &event.CreatedAt,
+		&event.ISIN, &event.Exchange,
 	)
+	if err != nil {
+		return event, err
+	}
+	event.RiskSignals, err = unmarshalRiskSignals(signals)
+	return event, err
+}
+
+func (r *rewardRepository) GetRewardEventByIdempotencyKey(ctx context.Context, key string) (*models.RewardEvent, error) {
+	query := `
+		SELECT ` + rewardEventColumns + `
+		FROM reward_events
+		WHERE idempotency_key = $1
+	`
+
+	event, err := scanRewardEvent(r.db.QueryRowContext(ctx, query, key))
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return event, err
+}
+
+// GetRewardEventByIDForUpdate locks the reward row until the surrounding
+// transaction ends. Must be called on a repository bound with WithTx.
+func (r *rewardRepository) GetRewardEventByIDForUpdate(ctx context.Context, id int64) (*models.RewardEvent, error) {
+	query := `
+		SELECT ` + rewardEventColumns + `
+		FROM reward_events
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	event, err := scanRewardEvent(r.db.QueryRowContext(ctx, query, id))
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -72,22 +141,31 @@ func (r *rewardRepository) GetRewardEventByIdempotencyKey(key string) (*models.R
 	return event, err
 }
 
-func (r *rewardRepository) GetTodayRewards(userID string) ([]models.RewardEvent, error) {
+// InvalidateRewardEvent flags a reward as invalidated; the rest of the row is never modified
+func (r *rewardRepository) InvalidateRewardEvent(ctx context.Context, id int64, reason string) error {
+	query := `
+		UPDATE reward_events
+		SET invalidated_at = NOW(), invalidation_reason = $2
+		WHERE id = $1 AND invalidated_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, reason)
+	return err
+}
+
+func (r *rewardRepository) GetTodayRewards(ctx context.Context, userID string) ([]models.RewardEvent, error) {
 	now := time.Now()
 	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
 	query := `
-		SELECT id, idempotency_key, user_id, stock_symbol, shares_quantity,
-			   price_per_share, total_value, brokerage_fee, stt_fee, gst_fee,
-			   exchange_fee, sebi_fee, total_fees, total_cost, reason, metadata,
-			   rewarded_at, created_at
+		SELECT ` + rewardEventColumns + `
 		FROM reward_events
 		WHERE user_id = $1 AND rewarded_at >= $2 AND rewarded_at < $3
 		ORDER BY rewarded_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID, startOfDay, endOfDay)
+	rows, err := r.replica.QueryContext(ctx, query, userID, startOfDay, endOfDay)
 	if err != nil {
 		return nil, err
 	}
@@ -95,38 +173,28 @@ func (r *rewardRepository) GetTodayRewards(userID string) ([]models.RewardEvent,
 
 	var events []models.RewardEvent
 	for rows.Next() {
-		var event models.RewardEvent
-		err := rows.Scan(
-			&event.ID, &event.IdempotencyKey, &event.UserID, &event.StockSymbol,
-			&event.SharesQuantity, &event.PricePerShare, &event.TotalValue,
-			&event.BrokerageFee, &event.STTFee, &event.GSTFee, &event.ExchangeFee,
-			&event.SEBIFee, &event.TotalFees, &event.TotalCost, &event.Reason,
-			&event.Metadata, &event.RewardedAt, &event.CreatedAt,
-		)
+		event, err := scanRewardEvent(rows)
 		if err != nil {
 			return nil, err
 		}
-		events = append(events, event)
+		events = append(events, *event)
 	}
 
 	return events, rows.Err()
 }
 
-func (r *rewardRepository) GetHistoricalRewards(userID string) ([]models.RewardEvent, error) {
+func (r *rewardRepository) GetHistoricalRewards(ctx context.Context, userID string) ([]models.RewardEvent, error) {
 	now := time.Now()
 	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 
 	query := `
-		SELECT id, idempotency_key, user_id, stock_symbol, shares_quantity,
-			   price_per_share, total_value, brokerage_fee, stt_fee, gst_fee,
-			   exchange_fee, sebi_fee, total_fees, total_cost, reason, metadata,
-			   rewarded_at, created_at
+		SELECT ` + rewardEventColumns + `
 		FROM reward_events
 		WHERE user_id = $1 AND rewarded_at < $2
 		ORDER BY rewarded_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID, startOfToday)
+	rows, err := r.replica.QueryContext(ctx, query, userID, startOfToday)
 	if err != nil {
 		return nil, err
 	}
@@ -134,32 +202,28 @@ func (r *rewardRepository) GetHistoricalRewards(userID string) ([]models.RewardE
 
 	var events []models.RewardEvent
 	for rows.Next() {
-		var event models.RewardEvent
-		err := rows.Scan(
-			&event.ID, &event.IdempotencyKey, &event.UserID, &event.StockSymbol,
-			&event.SharesQuantity, &event.PricePerShare, &event.TotalValue,
-			&event.BrokerageFee, &event.STTFee, &event.GSTFee, &event.ExchangeFee,
-			&event.SEBIFee, &event.TotalFees, &event.TotalCost, &event.Reason,
-			&event.Metadata, &event.RewardedAt, &event.CreatedAt,
-		)
+		event, err := scanRewardEvent(rows)
 		if err != nil {
 			return nil, err
 		}
-		events = append(events, event)
+		events = append(events, *event)
 	}
 
 	return events, rows.Err()
 }
 
-func (r *rewardRepository) GetUserHolding(userID, stockSymbol string) (*models.UserHolding, error) {
+// GetUserHoldingForUpdate locks the holding row until the surrounding
+// transaction ends. Must be called on a repository bound with WithTx.
+func (r *rewardRepository) GetUserHoldingForUpdate(ctx context.Context, userID, stockSymbol string) (*models.UserHolding, error) {
 	query := `
 		SELECT id, user_id, stock_symbol, total_shares, average_price, last_updated
 		FROM user_holdings
 		WHERE user_id = $1 AND stock_symbol = $2
+		FOR UPDATE
 	`
 
 	holding := &models.UserHolding{}
-	err := r.db.QueryRow(query, userID, stockSymbol).Scan(
+	err := r.db.QueryRowContext(ctx, query, userID, stockSymbol).Scan(
 		&holding.ID, &holding.UserID, &holding.StockSymbol,
 		&holding.TotalShares, &holding.AveragePrice, &holding.LastUpdated,
 	)
@@ -171,7 +235,32 @@ func (r *rewardRepository) GetUserHolding(userID, stockSymbol string) (*models.U
 	return holding, err
 }
 
-func (r *rewardRepository) UpsertUserHolding(holding *models.UserHolding) error {
+// DecrementUserHolding removes shares from a holding. The total_shares >= 0
+// check constraint rejects any update that would go negative.
+func (r *rewardRepository) DecrementUserHolding(ctx context.Context, userID, stockSymbol string, quantity float64) error {
+	query := `
+		UPDATE user_holdings
+		SET total_shares = total_shares - $3, last_updated = NOW()
+		WHERE user_id = $1 AND stock_symbol = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, stockSymbol, quantity)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("no holding found for user %s and stock %s", userID, stockSymbol)
+	}
+
+	return nil
+}
+
+func (r *rewardRepository) UpsertUserHolding(ctx context.Context, holding *models.UserHolding) error {
 	query := `
 		INSERT INTO user_holdings (user_id, stock_symbol, total_shares, average_price, last_updated)
 		VALUES ($1, $2, $3, $4, $5)
@@ -183,33 +272,66 @@ func (r *rewardRepository) UpsertUserHolding(holding *models.UserHolding) error
 		RETURNING id
 	`
 
-	return r.db.QueryRow(
+	return r.db.QueryRowContext(
+		ctx,
 		query,
 		holding.UserID, holding.StockSymbol, holding.TotalShares,
 		holding.AveragePrice, holding.LastUpdated,
 	).Scan(&holding.ID)
 }
 
-func (r *rewardRepository) GetUserPortfolio(userID string) ([]models.UserHolding, error) {
+// ApplyRewardToHolding adds a reward's shares to the holding and blends its price
+// into the weighted average in a single statement. The conflicting row is locked
+// for the update, so concurrent rewards for the same holding serialize instead of
+// overwriting each other.
+func (r *rewardRepository) ApplyRewardToHolding(ctx context.Context, event *models.RewardEvent) error {
 	query := `
-		SELECT id, user_id, stock_symbol, total_shares, average_price, last_updated
-		FROM user_holdings
-		WHERE user_id = $1 AND total_shares > 0
-		ORDER BY stock_symbol
+		INSERT INTO user_holdings (user_id, stock_symbol, total_shares, average_price, last_updated)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (user_id, stock_symbol)
+		DO UPDATE SET
+			average_price = CASE
+				WHEN user_holdings.total_shares + EXCLUDED.total_shares > 0 THEN
+					(user_holdings.total_shares * user_holdings.average_price +
+					 EXCLUDED.total_shares * EXCLUDED.average_price) /
+					(user_holdings.total_shares + EXCLUDED.total_shares)
+				ELSE EXCLUDED.average_price
+			END,
+			total_shares = user_holdings.total_shares + EXCLUDED.total_shares,
+			last_updated = NOW()
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		event.UserID, event.StockSymbol, event.SharesQuantity, event.PricePerShare,
+	)
+	return err
+}
+
+// GetUserPortfolio returns non-empty holdings with company names and primary
+// listings in one round trip
+func (r *rewardRepository) GetUserPortfolio(ctx context.Context, userID string) ([]models.PortfolioHolding, error) {
+	query := `
+		SELECT h.stock_symbol, COALESCE(s.company_name, h.stock_symbol), COALESCE(s.exchange, ''), h.total_shares, h.average_price
+		FROM user_holdings h
+		LEFT JOIN stocks s ON s.symbol = h.stock_symbol
+		WHERE h.user_id = $1 AND h.total_shares > 0
+		ORDER BY h.stock_symbol
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.replica.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var holdings []models.UserHolding
+	var holdings []models.PortfolioHolding
 	for rows.Next() {
-		var holding models.UserHolding
+		var holding models.PortfolioHolding
 		err := rows.Scan(
-			&holding.ID, &holding.UserID, &holding.StockSymbol,
-			&holding.TotalShares, &holding.AveragePrice, &holding.LastUpdated,
+			&holding.StockSymbol, &holding.CompanyName, &holding.Exchange,
+			&holding.TotalShares, &holding.AveragePrice,
 		)
 		if err != nil {
 			return nil, err
@@ -220,69 +342,227 @@ func (r *rewardRepository) GetUserPortfolio(userID string) ([]models.UserHolding
 	return holdings, rows.Err()
 }
 
+// ErrStockNotFound is returned by GetStockBySymbol for an unknown symbol
+var ErrStockNotFound = errors.New("stock not found")
+
 // StockRepository handles stock-related database operations
 type StockRepository interface {
-	GetStockBySymbol(symbol string) (*models.Stock, error)
-	CreateStockPrice(price *models.StockPrice) error
-	GetLatestStockPrice(symbol string) (*models.StockPrice, error)
-	GetLatestStockPrices() (map[string]float64, error)
+	GetStockBySymbol(ctx context.Context, symbol string) (*models.Stock, error)
+	// GetStockByISIN returns nil if no stock has the ISIN
+	GetStockByISIN(ctx context.Context, isin string) (*models.Stock, error)
+	ListStocks(ctx context.Context) ([]models.Stock, error)
+	UpsertStock(ctx context.Context, stock *models.Stock) error
+	ListListings(ctx context.Context) ([]models.StockListing, error)
+	// GetListing returns nil if the exchange has no listing under the symbol
+	GetListing(ctx context.Context, exchange, symbol string) (*models.StockListing, error)
+	UpsertListing(ctx context.Context, listing *models.StockListing) error
+	CreateStockPrice(ctx context.Context, price *models.StockPrice) error
+	// GetLatestStockPrice and GetLatestStockPrices return prices of each stock's primary listing
+	GetLatestStockPrice(ctx context.Context, symbol string) (*models.StockPrice, error)
+	GetLatestStockPrices(ctx context.Context) (map[string]models.StockPrice, error)
+	GetLatestListingPrice(ctx context.Context, symbol, exchange string) (*models.StockPrice, error)
+	WithTx(tx *sql.Tx) StockRepository
 }
 
 type stockRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 func NewStockRepository(db *sql.DB) StockRepository {
 	return &stockRepository{db: db}
 }
 
-func (r *stockRepository) GetStockBySymbol(symbol string) (*models.Stock, error) {
+// WithTx returns a copy of the repository bound to the given transaction. On a
+// cached repository the copy bypasses the cache.
+func (r *stockRepository) WithTx(tx *sql.Tx) StockRepository {
+	return &stockRepository{db: tx}
+}
+
+// stockColumns is the column list matching scanStock
+const stockColumns = `id, symbol, COALESCE(isin, ''), company_name, exchange, is_active, created_at, updated_at`
+
+func scanStock(row rowScanner) (*models.Stock, error) {
+	stock := &models.Stock{}
+	err := row.Scan(
+		&stock.ID, &stock.Symbol, &stock.ISIN, &stock.CompanyName,
+		&stock.Exchange, &stock.IsActive, &stock.CreatedAt, &stock.UpdatedAt,
+	)
+	return stock, err
+}
+
+func (r *stockRepository) GetStockBySymbol(ctx context.Context, symbol string) (*models.Stock, error) {
 	query := `
-		SELECT id, symbol, company_name, exchange, is_active, created_at, updated_at
+		SELECT ` + stockColumns + `
 		FROM stocks
 		WHERE symbol = $1
 	`
 
-	stock := &models.Stock{}
-	err := r.db.QueryRow(query, symbol).Scan(
-		&stock.ID, &stock.Symbol, &stock.CompanyName,
-		&stock.Exchange, &stock.IsActive, &stock.CreatedAt, &stock.UpdatedAt,
-	)
+	stock, err := scanStock(r.db.QueryRowContext(ctx, query, symbol))
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("stock not found: %s", symbol)
+		return nil, fmt.Errorf("%w: %s", ErrStockNotFound, symbol)
 	}
 
 	return stock, err
 }
 
-func (r *stockRepository) CreateStockPrice(price *models.StockPrice) error {
+func (r *stockRepository) GetStockByISIN(ctx context.Context, isin string) (*models.Stock, error) {
 	query := `
-		INSERT INTO stock_prices (stock_symbol, price, timestamp, source)
+		SELECT ` + stockColumns + `
+		FROM stocks
+		WHERE isin = $1
+	`
+
+	stock, err := scanStock(r.db.QueryRowContext(ctx, query, isin))
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return stock, err
+}
+
+func (r *stockRepository) ListStocks(ctx context.Context) ([]models.Stock, error) {
+	query := `
+		SELECT ` + stockColumns + `
+		FROM stocks
+		ORDER BY symbol
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stocks []models.Stock
+	for rows.Next() {
+		stock, err := scanStock(rows)
+		if err != nil {
+			return nil, err
+		}
+		stocks = append(stocks, *stock)
+	}
+
+	return stocks, rows.Err()
+}
+
+// UpsertStock creates a stock or updates its name, exchange and ISIN. The active flag of an
+// existing stock is left alone, so seeding never re-lists a delisted stock, and so is its
+// ISIN when none is given.
+func (r *stockRepository) UpsertStock(ctx context.Context, stock *models.Stock) error {
+	query := `
+		INSERT INTO stocks (symbol, company_name, exchange, isin)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		ON CONFLICT (symbol) DO UPDATE
+		SET company_name = EXCLUDED.company_name, exchange = EXCLUDED.exchange,
+			isin = COALESCE(EXCLUDED.isin, stocks.isin), updated_at = NOW()
+		RETURNING id, COALESCE(isin, ''), is_active, created_at, updated_at
+	`
+
+	return r.db.QueryRowContext(ctx, query, stock.Symbol, stock.CompanyName, stock.Exchange, stock.ISIN).Scan(
+		&stock.ID, &stock.ISIN, &stock.IsActive, &stock.CreatedAt, &stock.UpdatedAt,
+	)
+}
+
+// listingColumns is the column list matching scanListing; l is stock_listings and s is stocks
+const listingColumns = `l.id, l.isin, l.exchange, l.symbol, s.symbol, l.is_active, l.created_at, l.updated_at`
+
+func scanListing(row rowScanner) (*models.StockListing, error) {
+	listing := &models.StockListing{}
+	err := row.Scan(
+		&listing.ID, &listing.ISIN, &listing.Exchange, &listing.Symbol,
+		&listing.StockSymbol, &listing.IsActive, &listing.CreatedAt, &listing.UpdatedAt,
+	)
+	return listing, err
+}
+
+func (r *stockRepository) ListListings(ctx context.Context) ([]models.StockListing, error) {
+	query := `
+		SELECT ` + listingColumns + `
+		FROM stock_listings l
+		JOIN stocks s ON s.isin = l.isin
+		ORDER BY s.symbol, l.exchange
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var listings []models.StockListing
+	for rows.Next() {
+		listing, err := scanListing(rows)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, *listing)
+	}
+
+	return listings, rows.Err()
+}
+
+func (r *stockRepository) GetListing(ctx context.Context, exchange, symbol string) (*models.StockListing, error) {
+	query := `
+		SELECT ` + listingColumns + `
+		FROM stock_listings l
+		JOIN stocks s ON s.isin = l.isin
+		WHERE l.exchange = $1 AND l.symbol = $2
+	`
+
+	listing, err := scanListing(r.db.QueryRowContext(ctx, query, exchange, symbol))
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return listing, err
+}
+
+// UpsertListing creates the listing of an ISIN on an exchange, or updates its ticker and
+// active flag, which is how a ticker rename is recorded
+func (r *stockRepository) UpsertListing(ctx context.Context, listing *models.StockListing) error {
+	query := `
+		INSERT INTO stock_listings (isin, exchange, symbol, is_active)
 		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (stock_symbol, timestamp) DO UPDATE
+		ON CONFLICT (isin, exchange) DO UPDATE
+		SET symbol = EXCLUDED.symbol, is_active = EXCLUDED.is_active, updated_at = NOW()
+		RETURNING id, (SELECT symbol FROM stocks WHERE stocks.isin = stock_listings.isin), created_at, updated_at
+	`
+
+	return r.db.QueryRowContext(ctx, query, listing.ISIN, listing.Exchange, listing.Symbol, listing.IsActive).Scan(
+		&listing.ID, &listing.StockSymbol, &listing.CreatedAt, &listing.UpdatedAt,
+	)
+}
+
+func (r *stockRepository) CreateStockPrice(ctx context.Context, price *models.StockPrice) error {
+	query := `
+		INSERT INTO stock_prices (stock_symbol, exchange, price, timestamp, source)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (stock_symbol, exchange, timestamp) DO UPDATE
 		SET price = EXCLUDED.price, source = EXCLUDED.source
 		RETURNING id
 	`
 
-	return r.db.QueryRow(
+	return r.db.QueryRowContext(
+		ctx,
 		query,
-		price.StockSymbol, price.Price, price.Timestamp, price.Source,
+		price.StockSymbol, price.Exchange, price.Price, price.Timestamp, price.Source,
 	).Scan(&price.ID)
 }
 
-func (r *stockRepository) GetLatestStockPrice(symbol string) (*models.StockPrice, error) {
+func (r *stockRepository) GetLatestStockPrice(ctx context.Context, symbol string) (*models.StockPrice, error) {
 	query := `
-		SELECT id, stock_symbol, price, timestamp, source
-		FROM stock_prices
-		WHERE stock_symbol = $1
-		ORDER BY timestamp DESC
-		LIMIT 1
+		SELECT lp.price_id, lp.stock_symbol, lp.exchange, lp.price, lp.timestamp, lp.source
+		FROM latest_stock_prices lp
+		JOIN stocks s ON s.symbol = lp.stock_symbol AND s.exchange = lp.exchange
+		WHERE lp.stock_symbol = $1
 	`
 
 	price := &models.StockPrice{}
-	err := r.db.QueryRow(query, symbol).Scan(
-		&price.ID, &price.StockSymbol, &price.Price, &price.Timestamp, &price.Source,
+	err := r.db.QueryRowContext(ctx, query, symbol).Scan(
+		&price.ID, &price.StockSymbol, &price.Exchange, &price.Price, &price.Timestamp, &price.Source,
 	)
 
 	if err == sql.ErrNoRows {
@@ -292,69 +572,139 @@ func (r *stockRepository) GetLatestStockPrice(symbol string) (*models.StockPrice
 	return price, err
 }
 
-func (r *stockRepository) GetLatestStockPrices() (map[string]float64, error) {
+func (r *stockRepository) GetLatestStockPrices(ctx context.Context) (map[string]models.StockPrice, error) {
 	query := `
-		SELECT DISTINCT ON (stock_symbol) stock_symbol, price
-		FROM stock_prices
-		ORDER BY stock_symbol, timestamp DESC
+		SELECT lp.price_id, lp.stock_symbol, lp.exchange, lp.price, lp.timestamp, lp.source
+		FROM latest_stock_prices lp
+		JOIN stocks s ON s.symbol = lp.stock_symbol AND s.exchange = lp.exchange
 	`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	prices := make(map[string]float64)
+	prices := make(map[string]models.StockPrice)
 	for rows.Next() {
-		var symbol string
-		var price float64
-		if err := rows.Scan(&symbol, &price); err != nil {
+		var price models.StockPrice
+		if err := rows.Scan(&price.ID, &price.StockSymbol, &price.Exchange, &price.Price, &price.Timestamp, &price.Source); err != nil {
 			return nil, err
 		}
-		prices[symbol] = price
+		prices[price.StockSymbol] = price
 	}
 
 	return prices, rows.Err()
 }
 
+func (r *stockRepository) GetLatestListingPrice(ctx context.Context, symbol, exchange string) (*models.StockPrice, error) {
+	query := `
+		SELECT price_id, stock_symbol, exchange, price, timestamp, source
+		FROM latest_stock_prices
+		WHERE stock_symbol = $1 AND exchange = $2
+	`
+
+	price := &models.StockPrice{}
+	err := r.db.QueryRowContext(ctx, query, symbol, exchange).Scan(
+		&price.ID, &price.StockSymbol, &price.Exchange, &price.Price, &price.Timestamp, &price.Source,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no price found for stock: %s on %s", symbol, exchange)
+	}
+
+	return price, err
+}
+
 // LedgerRepository handles ledger operations
 type LedgerRepository interface {
-	CreateLedgerEntries(entries []models.LedgerEntry) error
+	CreateLedgerEntries(ctx context.Context, entries []models.LedgerEntry) error
+	GetUnpostedRewards(ctx context.Context, createdBefore time.Time, limit int) ([]models.RewardEvent, error)
+	WithTx(tx *sql.Tx) LedgerRepository
 }
 
 type ledgerRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 func NewLedgerRepository(db *sql.DB) LedgerRepository {
 	return &ledgerRepository{db: db}
 }
 
-func (r *ledgerRepository) CreateLedgerEntries(entries []models.LedgerEntry) error {
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *ledgerRepository) WithTx(tx *sql.Tx) LedgerRepository {
+	return &ledgerRepository{db: tx}
+}
+
+// CreateLedgerEntries inserts a balanced group of entries atomically. When
+// bound to a caller's transaction the entries commit with it.
+func (r *ledgerRepository) CreateLedgerEntries(ctx context.Context, entries []models.LedgerEntry) error {
+	if db, ok := r.db.(*sql.DB); ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if err := r.WithTx(tx).CreateLedgerEntries(ctx, entries); err != nil {
+			return err
+		}
+		return tx.Commit()
 	}
-	defer tx.Rollback()
 
 	query := `
 		INSERT INTO ledger_entries (
-			entry_group_id, reward_event_id, account_type, stock_symbol,
-			debit_amount, credit_amount, description
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			entry_group_id, reward_event_id, disposal_id, vesting_schedule_id, account_type,
+			stock_symbol, debit_amount, credit_amount, description
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
 	for _, entry := range entries {
-		_, err := tx.Exec(
+		_, err := r.db.ExecContext(
+			ctx,
 			query,
-			entry.EntryGroupID, entry.RewardEventID, entry.AccountType, entry.StockSymbol,
-			entry.DebitAmount, entry.CreditAmount, entry.Description,
+			entry.EntryGroupID, entry.RewardEventID, entry.DisposalID, entry.VestingScheduleID, entry.AccountType,
+			entry.StockSymbol, entry.DebitAmount, entry.CreditAmount, entry.Description,
 		)
 		if err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	return nil
+}
+
+// GetUnpostedRewards returns rewards created before createdBefore that have no
+// ledger entries of their own, oldest first
+func (r *ledgerRepository) GetUnpostedRewards(ctx context.Context, createdBefore time.Time, limit int) ([]models.RewardEvent, error) {
+	query := `
+		SELECT ` + rewardEventColumns + `
+		FROM reward_events r
+		WHERE r.created_at < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM ledger_entries l
+			WHERE l.reward_event_id = r.id
+			  AND l.disposal_id IS NULL
+			  AND l.vesting_schedule_id IS NULL
+		  )
+		ORDER BY r.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.RewardEvent
+	for rows.Next() {
+		event, err := scanRewardEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, rows.Err()
 }
@@ -0,0 +1,303 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// Reward attributes the risk checks count distinct users by
+const (
+	RiskByDevice   = "device_id"
+	RiskByIP       = "ip_address"
+	RiskByMetadata = "metadata"
+)
+
+// RiskRepository backs the reward risk checks, the review queue and the blocklist
+type RiskRepository interface {
+	LockRisk(ctx context.Context, key string) error
+	CountOtherUsers(ctx context.Context, attribute, value, userID string, since time.Time) (int, error)
+	CountUserRewards(ctx context.Context, userID string, since time.Time) (int, error)
+	MatchBlocklist(ctx context.Context, userID, deviceID, ipAddress string) ([]models.BlocklistEntry, error)
+	AddBlocklistEntry(ctx context.Context, entry *models.BlocklistEntry) error
+	ListBlocklist(ctx context.Context, kind string) ([]models.BlocklistEntry, error)
+	DeleteBlocklistEntry(ctx context.Context, id int64) (*models.BlocklistEntry, error)
+	CreateReview(ctx context.Context, review *models.RewardReview) error
+	GetReview(ctx context.Context, id int64) (*models.RewardReview, error)
+	GetReviewByIdempotencyKey(ctx context.Context, key string) (*models.RewardReview, error)
+	ListReviews(ctx context.Context, status string, limit int) ([]models.RewardReview, error)
+	ResolveReview(ctx context.Context, id int64, status, reviewedBy, note string, rewardEventID sql.NullInt64) (bool, error)
+	WithTx(tx *sql.Tx) RiskRepository
+}
+
+type riskRepository struct {
+	db DBTX
+}
+
+func NewRiskRepository(db *sql.DB) RiskRepository {
+	return &riskRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *riskRepository) WithTx(tx *sql.Tx) RiskRepository {
+	return &riskRepository{db: tx}
+}
+
+// LockRisk takes a transaction-scoped advisory lock on key, so concurrent rewards
+// sharing a device, IP or metadata are counted one after the other
+func (r *riskRepository) LockRisk(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, "reward_risk:"+key)
+	return err
+}
+
+// CountOtherUsers counts users other than userID with a reward attempt since the given
+// time sharing the attribute's value. Attempts are issued rewards plus held or denied
+// ones, so a flagged pattern keeps counting while it waits for review.
+func (r *riskRepository) CountOtherUsers(ctx context.Context, attribute, value, userID string, since time.Time) (int, error) {
+	var match string
+	switch attribute {
+	case RiskByDevice, RiskByIP:
+		match = attribute + " = $1"
+	case RiskByMetadata:
+		match = "metadata = $1::jsonb"
+	default:
+		return 0, fmt.Errorf("unknown risk attribute %q", attribute)
+	}
+
+	query := `
+		SELECT COUNT(DISTINCT user_id)
+		FROM (
+			SELECT user_id FROM reward_events
+			WHERE ` + match + ` AND created_at >= $2 AND invalidated_at IS NULL
+			UNION ALL
+			SELECT user_id FROM reward_risk_reviews
+			WHERE ` + match + ` AND created_at >= $2 AND status <> 'approved'
+		) attempts
+		WHERE user_id <> $3
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, value, since, userID).Scan(&count)
+	return count, err
+}
+
+// CountUserRewards counts a user's reward attempts since the given time
+func (r *riskRepository) CountUserRewards(ctx context.Context, userID string, since time.Time) (int, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM reward_events
+			 WHERE user_id = $1 AND created_at >= $2 AND invalidated_at IS NULL) +
+			(SELECT COUNT(*) FROM reward_risk_reviews
+			 WHERE user_id = $1 AND created_at >= $2 AND status <> 'approved')
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userID, since).Scan(&count)
+	return count, err
+}
+
+const blocklistColumns = `id, kind, value, reason, created_by, created_at`
+
+func scanBlocklistEntries(rows *sql.Rows) ([]models.BlocklistEntry, error) {
+	defer rows.Close()
+
+	var entries []models.BlocklistEntry
+	for rows.Next() {
+		var e models.BlocklistEntry
+		if err := rows.Scan(&e.ID, &e.Kind, &e.Value, &e.Reason, &e.CreatedBy, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// MatchBlocklist returns the entries blocking any of the given values. Empty values
+// match nothing; ip_address entries match an address inside their CIDR range.
+func (r *riskRepository) MatchBlocklist(ctx context.Context, userID, deviceID, ipAddress string) ([]models.BlocklistEntry, error) {
+	query := `
+		SELECT ` + blocklistColumns + `
+		FROM risk_blocklist
+		WHERE (kind = 'user_id' AND value = $1)
+		   OR ($2 <> '' AND kind = 'device_id' AND value = $2)
+		   OR ($3 <> '' AND kind = 'ip_address' AND value::inet >>= NULLIF($3, '')::inet)
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, deviceID, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	return scanBlocklistEntries(rows)
+}
+
+func (r *riskRepository) AddBlocklistEntry(ctx context.Context, entry *models.BlocklistEntry) error {
+	query := `
+		INSERT INTO risk_blocklist (kind, value, reason, created_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (kind, value) DO UPDATE SET reason = EXCLUDED.reason, created_by = EXCLUDED.created_by
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(ctx, query, entry.Kind, entry.Value, entry.Reason, entry.CreatedBy).
+		Scan(&entry.ID, &entry.CreatedAt)
+}
+
+// ListBlocklist returns the blocklist, optionally only one kind of entry
+func (r *riskRepository) ListBlocklist(ctx context.Context, kind string) ([]models.BlocklistEntry, error) {
+	query := `
+		SELECT ` + blocklistColumns + `
+		FROM risk_blocklist
+		WHERE $1 = '' OR kind = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, kind)
+	if err != nil {
+		return nil, err
+	}
+	return scanBlocklistEntries(rows)
+}
+
+// DeleteBlocklistEntry returns the deleted entry, or nil if there was none
+func (r *riskRepository) DeleteBlocklistEntry(ctx context.Context, id int64) (*models.BlocklistEntry, error) {
+	rows, err := r.db.QueryContext(ctx, `DELETE FROM risk_blocklist WHERE id = $1 RETURNING `+blocklistColumns, id)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := scanBlocklistEntries(rows)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+func (r *riskRepository) CreateReview(ctx context.Context, review *models.RewardReview) error {
+	signals, err := marshalRiskSignals(review.Signals)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO reward_risk_reviews (
+			idempotency_key, user_id, stock_symbol, device_id, ip_address,
+			metadata, request, decision, signals, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(ctx, query,
+		review.IdempotencyKey, review.UserID, review.StockSymbol, review.DeviceID, review.IPAddress,
+		review.Metadata, []byte(review.Request), review.Decision, signals, review.Status,
+	).Scan(&review.ID, &review.CreatedAt)
+}
+
+const reviewColumns = `id, idempotency_key, user_id, stock_symbol, device_id, ip_address,
+			   metadata, request, decision, signals, status, reward_event_id,
+			   reviewed_by, review_note, reviewed_at, created_at`
+
+func scanReview(row rowScanner) (*models.RewardReview, error) {
+	review := &models.RewardReview{}
+	var request, signals []byte
+	err := row.Scan(
+		&review.ID, &review.IdempotencyKey, &review.UserID, &review.StockSymbol,
+		&review.DeviceID, &review.IPAddress, &review.Metadata, &request,
+		&review.Decision, &signals, &review.Status, &review.RewardEventID,
+		&review.ReviewedBy, &review.ReviewNote, &review.ReviewedAt, &review.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	review.Request = json.RawMessage(request)
+	review.Signals, err = unmarshalRiskSignals(signals)
+	return review, err
+}
+
+func (r *riskRepository) getReview(ctx context.Context, where string, arg interface{}) (*models.RewardReview, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reward_risk_reviews
+		WHERE ` + where
+
+	review, err := scanReview(r.db.QueryRowContext(ctx, query, arg))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return review, err
+}
+
+func (r *riskRepository) GetReview(ctx context.Context, id int64) (*models.RewardReview, error) {
+	return r.getReview(ctx, "id = $1", id)
+}
+
+func (r *riskRepository) GetReviewByIdempotencyKey(ctx context.Context, key string) (*models.RewardReview, error) {
+	return r.getReview(ctx, "idempotency_key = $1", key)
+}
+
+// ListReviews returns reviews oldest first, optionally only those with one status
+func (r *riskRepository) ListReviews(ctx context.Context, status string, limit int) ([]models.RewardReview, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reward_risk_reviews
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at, id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []models.RewardReview
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, *review)
+	}
+	return reviews, rows.Err()
+}
+
+// ResolveReview closes a pending review. It reports false if the review was not
+// pending, e.g. because another reviewer got there first.
+func (r *riskRepository) ResolveReview(ctx context.Context, id int64, status, reviewedBy, note string, rewardEventID sql.NullInt64) (bool, error) {
+	query := `
+		UPDATE reward_risk_reviews
+		SET status = $2, reviewed_by = $3, review_note = NULLIF($4, ''), reviewed_at = NOW(), reward_event_id = $5
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status, reviewedBy, note, rewardEventID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+func marshalRiskSignals(signals []models.RiskSignal) ([]byte, error) {
+	if signals == nil {
+		signals = []models.RiskSignal{}
+	}
+	data, err := json.Marshal(signals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode risk signals: %w", err)
+	}
+	return data, nil
+}
+
+func unmarshalRiskSignals(data []byte) ([]models.RiskSignal, error) {
+	signals := []models.RiskSignal{}
+	if len(data) == 0 {
+		return signals, nil
+	}
+	if err := json.Unmarshal(data, &signals); err != nil {
+		return nil, fmt.Errorf("failed to decode risk signals: %w", err)
+	}
+	return signals, nil
+}
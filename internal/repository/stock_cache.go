@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// StockCacheConfig controls how long cached stock data may be served before a reload
+type StockCacheConfig struct {
+	StockTTL time.Duration // Stock master (names, active flag) changes rarely
+	PriceTTL time.Duration // Bounds staleness when another instance writes prices
+}
+
+// cachedStockRepository keeps the stock master and the latest price of each stock's
+// primary listing in memory. Prices written through CreateStockPrice update the cache
+// immediately; everything is reloaded from the database in bulk once its TTL has passed.
+// Listings, and prices of other listings, are read from the database.
+type cachedStockRepository struct {
+	StockRepository
+	config StockCacheConfig
+
+	mu       sync.RWMutex
+	stocks   map[string]models.Stock
+	stocksAt time.Time
+	prices   map[string]models.StockPrice
+	pricesAt time.Time
+
+	// Serialise reloads so a cold cache doesn't send every request to the database
+	stockLoadMu sync.Mutex
+	priceLoadMu sync.Mutex
+}
+
+// NewCachedStockRepository wraps a StockRepository with an in-memory read-through cache
+func NewCachedStockRepository(inner StockRepository, config StockCacheConfig) StockRepository {
+	return &cachedStockRepository{
+		StockRepository: inner,
+		config:          config,
+	}
+}
+
+func (r *cachedStockRepository) GetStockBySymbol(ctx context.Context, symbol string) (*models.Stock, error) {
+	if err := r.ensureStocks(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	stock, ok := r.stocks[symbol]
+	r.mu.RUnlock()
+	if ok {
+		return &stock, nil
+	}
+
+	// Not cached: it may have been added since the last reload
+	fetched, err := r.StockRepository.GetStockBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.stocks[symbol] = *fetched
+	r.mu.Unlock()
+
+	return fetched, nil
+}
+
+func (r *cachedStockRepository) GetStockByISIN(ctx context.Context, isin string) (*models.Stock, error) {
+	if err := r.ensureStocks(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	for _, stock := range r.stocks {
+		if stock.ISIN == isin {
+			r.mu.RUnlock()
+			return &stock, nil
+		}
+	}
+	r.mu.RUnlock()
+
+	// Not cached: it may have been added, or given its ISIN, since the last reload
+	fetched, err := r.StockRepository.GetStockByISIN(ctx, isin)
+	if err != nil || fetched == nil {
+		return fetched, err
+	}
+
+	r.mu.Lock()
+	r.stocks[fetched.Symbol] = *fetched
+	r.mu.Unlock()
+
+	return fetched, nil
+}
+
+func (r *cachedStockRepository) ListStocks(ctx context.Context) ([]models.Stock, error) {
+	if err := r.ensureStocks(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stocks := make([]models.Stock, 0, len(r.stocks))
+	for _, stock := range r.stocks {
+		stocks = append(stocks, stock)
+	}
+	sort.Slice(stocks, func(i, j int) bool { return stocks[i].Symbol < stocks[j].Symbol })
+	return stocks, nil
+}
+
+func (r *cachedStockRepository) CreateStockPrice(ctx context.Context, price *models.StockPrice) error {
+	if err := r.StockRepository.CreateStockPrice(ctx, price); err != nil {
+		return err
+	}
+
+	// Only primary listings are cached; if the stock can't be loaded the next reload picks the price up
+	if stock, err := r.GetStockBySymbol(ctx, price.StockSymbol); err != nil || stock.Exchange != price.Exchange {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Backfills of older prices must not replace the latest one
+	if r.prices != nil {
+		if cached, ok := r.prices[price.StockSymbol]; !ok || !price.Timestamp.Before(cached.Timestamp) {
+			r.prices[price.StockSymbol] = *price
+		}
+	}
+	return nil
+}
+
+func (r *cachedStockRepository) GetLatestStockPrice(ctx context.Context, symbol string) (*models.StockPrice, error) {
+	if err := r.ensurePrices(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	price, ok := r.prices[symbol]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no price found for stock: %s", symbol)
+	}
+	return &price, nil
+}
+
+func (r *cachedStockRepository) GetLatestStockPrices(ctx context.Context) (map[string]models.StockPrice, error) {
+	if err := r.ensurePrices(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Hand out a copy; the cached map keeps changing under write-through
+	prices := make(map[string]models.StockPrice, len(r.prices))
+	for symbol, price := range r.prices {
+		prices[symbol] = price
+	}
+	return prices, nil
+}
+
+func (r *cachedStockRepository) GetLatestListingPrice(ctx context.Context, symbol, exchange string) (*models.StockPrice, error) {
+	if stock, err := r.GetStockBySymbol(ctx, symbol); err == nil && stock.Exchange == exchange {
+		return r.GetLatestStockPrice(ctx, symbol)
+	}
+	return r.StockRepository.GetLatestListingPrice(ctx, symbol, exchange)
+}
+
+// ensureStocks reloads the stock master when it is missing or expired
+func (r *cachedStockRepository) ensureStocks(ctx context.Context) error {
+	if r.fresh(&r.stocksAt, r.config.StockTTL) {
+		return nil
+	}
+
+	r.stockLoadMu.Lock()
+	defer r.stockLoadMu.Unlock()
+
+	// Another caller may have reloaded while we waited
+	if r.fresh(&r.stocksAt, r.config.StockTTL) {
+		return nil
+	}
+
+	list, err := r.StockRepository.ListStocks(ctx)
+	if err != nil {
+		return err
+	}
+	stocks := make(map[string]models.Stock, len(list))
+	for _, stock := range list {
+		stocks[stock.Symbol] = stock
+	}
+
+	r.mu.Lock()
+	r.stocks = stocks
+	r.stocksAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// ensurePrices reloads the latest prices when they are missing or expired
+func (r *cachedStockRepository) ensurePrices(ctx context.Context) error {
+	if r.fresh(&r.pricesAt, r.config.PriceTTL) {
+		return nil
+	}
+
+	r.priceLoadMu.Lock()
+	defer r.priceLoadMu.Unlock()
+
+	if r.fresh(&r.pricesAt, r.config.PriceTTL) {
+		return nil
+	}
+
+	prices, err := r.StockRepository.GetLatestStockPrices(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	// Keep anything written through while the reload was in flight
+	for symbol, cached := range r.prices {
+		if loaded, ok := prices[symbol]; !ok || cached.Timestamp.After(loaded.Timestamp) {
+			prices[symbol] = cached
+		}
+	}
+	r.prices = prices
+	r.pricesAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// fresh reports whether a cache loaded at *loadedAt is still within ttl; zero means never loaded
+func (r *cachedStockRepository) fresh(loadedAt *time.Time, ttl time.Duration) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return !loadedAt.IsZero() && time.Since(*loadedAt) < ttl
+}
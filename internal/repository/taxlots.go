@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// TaxLotRepository handles per-reward acquisition lots and their disposals
+type TaxLotRepository interface {
+	CreateTaxLot(ctx context.Context, lot *models.TaxLot) error
+	GetOpenLotsForUpdate(ctx context.Context, userID, stockSymbol string) ([]models.TaxLot, error)
+	GetLotByRewardEventForUpdate(ctx context.Context, rewardEventID int64) (*models.TaxLot, error)
+	ConsumeLot(ctx context.Context, lotID int64, quantity float64) error
+	CreateLotDisposal(ctx context.Context, disposal *models.LotDisposal) error
+	GetLotsAcquiredBetween(ctx context.Context, userID string, from, to time.Time) ([]models.TaxLot, error)
+	GetLotDisposalsBetween(ctx context.Context, userID string, from, to time.Time) ([]models.LotDisposal, error)
+	WithTx(tx *sql.Tx) TaxLotRepository
+}
+
+type taxLotRepository struct {
+	db      DBTX
+	replica DBTX // tax report reads; the primary when no replica is configured
+}
+
+func NewTaxLotRepository(db *sql.DB) TaxLotRepository {
+	return &taxLotRepository{db: db, replica: db}
+}
+
+// NewTaxLotRepositoryWithReplica sends tax report reads to replica
+func NewTaxLotRepositoryWithReplica(db, replica *sql.DB) TaxLotRepository {
+	return &taxLotRepository{db: db, replica: replica}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction.
+// Every query, including reads, then runs inside it.
+func (r *taxLotRepository) WithTx(tx *sql.Tx) TaxLotRepository {
+	return &taxLotRepository{db: tx, replica: tx}
+}
+
+const taxLotColumns = `id, reward_event_id, user_id, stock_symbol, acquired_at,
+			   quantity, remaining_quantity, cost_per_share, created_at`
+
+func scanTaxLot(row rowScanner) (*models.TaxLot, error) {
+	lot := &models.TaxLot{}
+	err := row.Scan(
+		&lot.ID, &lot.RewardEventID, &lot.UserID, &lot.StockSymbol, &lot.AcquiredAt,
+		&lot.Quantity, &lot.RemainingQuantity, &lot.CostPerShare, &lot.CreatedAt,
+	)
+	return lot, err
+}
+
+func (r *taxLotRepository) CreateTaxLot(ctx context.Context, lot *models.TaxLot) error {
+	query := `
+		INSERT INTO tax_lots (
+			reward_event_id, user_id, stock_symbol, acquired_at,
+			quantity, remaining_quantity, cost_per_share
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		lot.RewardEventID, lot.UserID, lot.StockSymbol, lot.AcquiredAt,
+		lot.Quantity, lot.RemainingQuantity, lot.CostPerShare,
+	).Scan(&lot.ID, &lot.CreatedAt)
+}
+
+// GetOpenLotsForUpdate locks a user's lots with remaining shares, oldest
+// first, until the surrounding transaction ends
+func (r *taxLotRepository) GetOpenLotsForUpdate(ctx context.Context, userID, stockSymbol string) ([]models.TaxLot, error) {
+	query := `
+		SELECT ` + taxLotColumns + `
+		FROM tax_lots
+		WHERE user_id = $1 AND stock_symbol = $2 AND remaining_quantity > 0
+		ORDER BY acquired_at, id
+		FOR UPDATE
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, stockSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lots []models.TaxLot
+	for rows.Next() {
+		lot, err := scanTaxLot(rows)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, *lot)
+	}
+
+	return lots, rows.Err()
+}
+
+// GetLotByRewardEventForUpdate locks the lot created by a reward
+func (r *taxLotRepository) GetLotByRewardEventForUpdate(ctx context.Context, rewardEventID int64) (*models.TaxLot, error) {
+	query := `
+		SELECT ` + taxLotColumns + `
+		FROM tax_lots
+		WHERE reward_event_id = $1
+		FOR UPDATE
+	`
+
+	lot, err := scanTaxLot(r.db.QueryRowContext(ctx, query, rewardEventID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return lot, err
+}
+
+// ConsumeLot reduces a lot's remaining quantity; the check constraint
+// rejects consuming more than remains
+func (r *taxLotRepository) ConsumeLot(ctx context.Context, lotID int64, quantity float64) error {
+	query := `
+		UPDATE tax_lots
+		SET remaining_quantity = remaining_quantity - $2
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, lotID, quantity)
+	return err
+}
+
+func (r *taxLotRepository) CreateLotDisposal(ctx context.Context, disposal *models.LotDisposal) error {
+	query := `
+		INSERT INTO lot_disposals (
+			tax_lot_id, disposal_id, user_id, stock_symbol, quantity,
+			acquired_at, disposed_at, cost_per_share, proceeds_per_share
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		disposal.TaxLotID, disposal.DisposalID, disposal.UserID, disposal.StockSymbol, disposal.Quantity,
+		disposal.AcquiredAt, disposal.DisposedAt, disposal.CostPerShare, disposal.ProceedsPerShare,
+	).Scan(&disposal.ID, &disposal.CreatedAt)
+}
+
+// GetLotsAcquiredBetween returns lots acquired in [from, to), oldest first, with
+// the quantity their vesting schedule forfeited
+func (r *taxLotRepository) GetLotsAcquiredBetween(ctx context.Context, userID string, from, to time.Time) ([]models.TaxLot, error) {
+	query := `
+		SELECT ` + taxLotColumns + `,
+			   COALESCE((SELECT vs.forfeited_quantity FROM vesting_schedules vs
+						 WHERE vs.reward_event_id = tax_lots.reward_event_id), 0)
+		FROM tax_lots
+		WHERE user_id = $1 AND acquired_at >= $2 AND acquired_at < $3
+		ORDER BY acquired_at, id
+	`
+
+	rows, err := r.replica.QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lots []models.TaxLot
+	for rows.Next() {
+		var lot models.TaxLot
+		err := rows.Scan(
+			&lot.ID, &lot.RewardEventID, &lot.UserID, &lot.StockSymbol, &lot.AcquiredAt,
+			&lot.Quantity, &lot.RemainingQuantity, &lot.CostPerShare, &lot.CreatedAt,
+			&lot.ForfeitedQuantity,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, lot)
+	}
+
+	return lots, rows.Err()
+}
+
+// GetLotDisposalsBetween returns taxable lot disposals made in [from, to),
+// oldest first. Transfers to the user's own demat account are not a
+// transfer for capital gains purposes and are excluded.
+func (r *taxLotRepository) GetLotDisposalsBetween(ctx context.Context, userID string, from, to time.Time) ([]models.LotDisposal, error) {
+	query := `
+		SELECT ld.id, ld.tax_lot_id, ld.disposal_id, ld.user_id, ld.stock_symbol, ld.quantity,
+			   ld.acquired_at, ld.disposed_at, ld.cost_per_share, ld.proceeds_per_share, ld.created_at
+		FROM lot_disposals ld
+		LEFT JOIN disposals d ON d.id = ld.disposal_id
+		WHERE ld.user_id = $1 AND ld.disposed_at >= $2 AND ld.disposed_at < $3
+		  AND d.disposal_type IS DISTINCT FROM 'transfer_out'
+		ORDER BY ld.disposed_at, ld.id
+	`
+
+	rows, err := r.replica.QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var disposals []models.LotDisposal
+	for rows.Next() {
+		var d models.LotDisposal
+		err := rows.Scan(
+			&d.ID, &d.TaxLotID, &d.DisposalID, &d.UserID, &d.StockSymbol, &d.Quantity,
+			&d.AcquiredAt, &d.DisposedAt, &d.CostPerShare, &d.ProceedsPerShare, &d.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		disposals = append(disposals, d)
+	}
+
+	return disposals, rows.Err()
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+// VestingRepository handles vesting schedules attached to rewards
+type VestingRepository interface {
+	CreateVestingSchedule(ctx context.Context, schedule *models.VestingSchedule) error
+	GetScheduleByRewardEvent(ctx context.Context, rewardEventID int64) (*models.VestingSchedule, error)
+	GetActiveSchedules(ctx context.Context, userID string) ([]models.VestingSchedule, error)
+	GetActiveSchedulesForStock(ctx context.Context, userID, stockSymbol string) ([]models.VestingSchedule, error)
+	GetActiveSchedulesBySymbol(ctx context.Context, stockSymbol string) ([]models.VestingSchedule, error)
+	MarkForfeited(ctx context.Context, id int64, quantity float64, reason string, at time.Time) error
+	WithTx(tx *sql.Tx) VestingRepository
+}
+
+type vestingRepository struct {
+	db      DBTX
+	replica DBTX // the portfolio's active-schedule read; the primary when no replica is configured
+}
+
+func NewVestingRepository(db *sql.DB) VestingRepository {
+	return &vestingRepository{db: db, replica: db}
+}
+
+// NewVestingRepositoryWithReplica sends the portfolio's active-schedule read to replica
+func NewVestingRepositoryWithReplica(db, replica *sql.DB) VestingRepository {
+	return &vestingRepository{db: db, replica: replica}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction.
+// Every query, including reads, then runs inside it.
+func (r *vestingRepository) WithTx(tx *sql.Tx) VestingRepository {
+	return &vestingRepository{db: tx, replica: tx}
+}
+
+const vestingScheduleColumns = `id, reward_event_id, user_id, stock_symbol, schedule_type,
+			   total_quantity, start_at, cliff_at, end_at, tranches, status,
+			   forfeited_quantity, forfeited_at, forfeit_reason, created_at`
+
+func scanVestingSchedule(row rowScanner) (*models.VestingSchedule, error) {
+	schedule := &models.VestingSchedule{}
+	var tranches []byte
+	err := row.Scan(
+		&schedule.ID, &schedule.RewardEventID, &schedule.UserID, &schedule.StockSymbol, &schedule.ScheduleType,
+		&schedule.TotalQuantity, &schedule.StartAt, &schedule.CliffAt, &schedule.EndAt, &tranches, &schedule.Status,
+		&schedule.ForfeitedQuantity, &schedule.ForfeitedAt, &schedule.ForfeitReason, &schedule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(tranches) > 0 {
+		if err := json.Unmarshal(tranches, &schedule.Tranches); err != nil {
+			return nil, fmt.Errorf("invalid tranches in vesting schedule %d: %w", schedule.ID, err)
+		}
+	}
+	return schedule, nil
+}
+
+func (r *vestingRepository) CreateVestingSchedule(ctx context.Context, schedule *models.VestingSchedule) error {
+	var tranches []byte
+	if len(schedule.Tranches) > 0 {
+		var err error
+		if tranches, err = json.Marshal(schedule.Tranches); err != nil {
+			return fmt.Errorf("failed to encode tranches: %w", err)
+		}
+	}
+
+	query := `
+		INSERT INTO vesting_schedules (
+			reward_event_id, user_id, stock_symbol, schedule_type, total_quantity,
+			start_at, cliff_at, end_at, tranches
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, status, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		schedule.RewardEventID, schedule.UserID, schedule.StockSymbol, schedule.ScheduleType, schedule.TotalQuantity,
+		schedule.StartAt, schedule.CliffAt, schedule.EndAt, tranches,
+	).Scan(&schedule.ID, &schedule.Status, &schedule.CreatedAt)
+}
+
+func (r *vestingRepository) GetScheduleByRewardEvent(ctx context.Context, rewardEventID int64) (*models.VestingSchedule, error) {
+	query := `
+		SELECT ` + vestingScheduleColumns + `
+		FROM vesting_schedules
+		WHERE reward_event_id = $1
+	`
+
+	schedule, err := scanVestingSchedule(r.db.QueryRowContext(ctx, query, rewardEventID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return schedule, err
+}
+
+func (r *vestingRepository) GetActiveSchedules(ctx context.Context, userID string) ([]models.VestingSchedule, error) {
+	query := `
+		SELECT ` + vestingScheduleColumns + `
+		FROM vesting_schedules
+		WHERE user_id = $1 AND status = 'active'
+		ORDER BY start_at
+	`
+
+	return r.querySchedules(ctx, r.replica, query, userID)
+}
+
+func (r *vestingRepository) GetActiveSchedulesForStock(ctx context.Context, userID, stockSymbol string) ([]models.VestingSchedule, error) {
+	query := `
+		SELECT ` + vestingScheduleColumns + `
+		FROM vesting_schedules
+		WHERE user_id = $1 AND stock_symbol = $2 AND status = 'active'
+		ORDER BY start_at
+	`
+
+	return r.querySchedules(ctx, r.db, query, userID, stockSymbol)
+}
+
+// GetActiveSchedulesBySymbol returns every user's active schedules for a stock
+func (r *vestingRepository) GetActiveSchedulesBySymbol(ctx context.Context, stockSymbol string) ([]models.VestingSchedule, error) {
+	query := `
+		SELECT ` + vestingScheduleColumns + `
+		FROM vesting_schedules
+		WHERE stock_symbol = $1 AND status = 'active'
+		ORDER BY user_id, start_at
+	`
+
+	return r.querySchedules(ctx, r.db, query, stockSymbol)
+}
+
+func (r *vestingRepository) querySchedules(ctx context.Context, db DBTX, query string, args ...interface{}) ([]models.VestingSchedule, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []models.VestingSchedule
+	for rows.Next() {
+		schedule, err := scanVestingSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+// MarkForfeited stores at in UTC, like the other timestamps reconciliation orders by
+func (r *vestingRepository) MarkForfeited(ctx context.Context, id int64, quantity float64, reason string, at time.Time) error {
+	query := `
+		UPDATE vesting_schedules
+		SET status = 'forfeited', forfeited_quantity = $2, forfeit_reason = $3, forfeited_at = $4
+		WHERE id = $1 AND status = 'active'
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, quantity, reason, at.UTC())
+	return err
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stocky/assignment/internal/models"
+)
+
+// PendingDelivery is a claimed webhook delivery with everything needed to send it
+type PendingDelivery struct {
+	ID                 int64
+	Attempts           int
+	EventID            string
+	EventType          string
+	Payload            []byte
+	SubscriptionID     int64
+	SubscriptionURL    string
+	SubscriptionSecret string
+	SubscriptionActive bool
+}
+
+// WebhookRepository handles webhook subscriptions, the transactional outbox and delivery state
+type WebhookRepository interface {
+	CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error
+	DispatchOutboxEvents(ctx context.Context, limit int) (int64, error)
+
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error)
+	DeactivateSubscription(ctx context.Context, id int64) (bool, error)
+
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]PendingDelivery, error)
+	MarkDelivered(ctx context.Context, id int64, statusCode int) error
+	MarkFailed(ctx context.Context, id int64, statusCode int, errMsg string, nextAttemptAt time.Time, dead bool) error
+	ListDeliveries(ctx context.Context, status string, limit int) ([]models.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, id int64) (bool, error)
+
+	WithTx(tx *sql.Tx) WebhookRepository
+}
+
+type webhookRepository struct {
+	db DBTX
+}
+
+func NewWebhookRepository(db *sql.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *webhookRepository) WithTx(tx *sql.Tx) WebhookRepository {
+	return &webhookRepository{db: tx}
+}
+
+// CreateOutboxEvent records an event; call it through WithTx so it commits with the change it describes
+func (r *webhookRepository) CreateOutboxEvent(ctx context.Context, event *models.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (event_id, event_type, aggregate_type, aggregate_id, payload)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		event.EventID, event.EventType, event.AggregateType, event.AggregateID, event.Payload,
+	).Scan(&event.ID, &event.CreatedAt)
+}
+
+// DispatchOutboxEvents fans undispatched outbox events out into one delivery per
+// matching active subscription and marks them dispatched, in a single statement.
+// SKIP LOCKED lets several workers run side by side.
+func (r *webhookRepository) DispatchOutboxEvents(ctx context.Context, limit int) (int64, error) {
+	query := `
+		WITH pending AS (
+			SELECT id, event_type
+			FROM outbox_events
+			WHERE dispatched_at IS NULL
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), fanned_out AS (
+			INSERT INTO webhook_deliveries (outbox_event_id, subscription_id)
+			SELECT p.id, s.id
+			FROM pending p
+			JOIN webhook_subscriptions s
+			  ON s.is_active
+			 AND (p.event_type = ANY(s.event_types) OR '*' = ANY(s.event_types))
+			ON CONFLICT (outbox_event_id, subscription_id) DO NOTHING
+		)
+		UPDATE outbox_events
+		SET dispatched_at = NOW()
+		WHERE id IN (SELECT id FROM pending)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const webhookSubscriptionColumns = `id, name, url, secret, event_types, is_active, created_at, updated_at`
+
+func scanWebhookSubscription(row rowScanner) (*models.WebhookSubscription, error) {
+	sub := &models.WebhookSubscription{}
+	err := row.Scan(
+		&sub.ID, &sub.Name, &sub.URL, &sub.Secret, pq.Array(&sub.EventTypes),
+		&sub.IsActive, &sub.CreatedAt, &sub.UpdatedAt,
+	)
+	return sub, err
+}
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (name, url, secret, event_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, is_active, created_at, updated_at
+	`
+
+	return r.db.QueryRowContext(
+		ctx,
+		query,
+		sub.Name, sub.URL, sub.Secret, pq.Array(sub.EventTypes),
+	).Scan(&sub.ID, &sub.IsActive, &sub.CreatedAt, &sub.UpdatedAt)
+}
+
+func (r *webhookRepository) ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []models.WebhookSubscription
+	for rows.Next() {
+		sub, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, *sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// DeactivateSubscription stops new deliveries to a subscription; history is kept
+func (r *webhookRepository) DeactivateSubscription(ctx context.Context, id int64) (bool, error) {
+	query := `
+		UPDATE webhook_subscriptions
+		SET is_active = FALSE, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// ClaimDueDeliveries leases pending deliveries whose next attempt is due by pushing
+// next_attempt_at forward. A worker that dies mid-send leaves the row to be retried
+// once the lease runs out.
+func (r *webhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]PendingDelivery, error) {
+	query := `
+		WITH due AS (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $2), updated_at = NOW()
+		FROM due, outbox_events e, webhook_subscriptions s
+		WHERE d.id = due.id
+		  AND e.id = d.outbox_event_id
+		  AND s.id = d.subscription_id
+		RETURNING d.id, d.attempts, e.event_id, e.event_type, e.payload,
+				  s.id, s.url, s.secret, s.is_active
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []PendingDelivery
+	for rows.Next() {
+		var d PendingDelivery
+		if err := rows.Scan(
+			&d.ID, &d.Attempts, &d.EventID, &d.EventType, &d.Payload,
+			&d.SubscriptionID, &d.SubscriptionURL, &d.SubscriptionSecret, &d.SubscriptionActive,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *webhookRepository) MarkDelivered(ctx context.Context, id int64, statusCode int) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'delivered', attempts = attempts + 1, last_status_code = $2,
+			last_error = NULL, delivered_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, statusCode)
+	return err
+}
+
+// MarkFailed records a failed attempt and either schedules the retry or dead-letters the delivery
+func (r *webhookRepository) MarkFailed(ctx context.Context, id int64, statusCode int, errMsg string, nextAttemptAt time.Time, dead bool) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = CASE WHEN $5 THEN 'dead' ELSE 'pending' END,
+			attempts = attempts + 1, last_status_code = $2, last_error = $3,
+			next_attempt_at = $4, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	code := sql.NullInt64{Int64: int64(statusCode), Valid: statusCode > 0}
+	_, err := r.db.ExecContext(ctx, query, id, code, errMsg, nextAttemptAt, dead)
+	return err
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, status string, limit int) ([]models.WebhookDelivery, error) {
+	query := `
+		SELECT d.id, d.outbox_event_id, d.subscription_id, e.event_type, d.status,
+			   d.attempts, d.next_attempt_at, d.last_status_code, d.last_error,
+			   d.delivered_at, d.created_at, d.updated_at
+		FROM webhook_deliveries d
+		JOIN outbox_events e ON e.id = d.outbox_event_id
+		WHERE ($1 = '' OR d.status = $1)
+		ORDER BY d.id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var d models.WebhookDelivery
+		if err := rows.Scan(
+			&d.ID, &d.OutboxEventID, &d.SubscriptionID, &d.EventType, &d.Status,
+			&d.Attempts, &d.NextAttemptAt, &d.LastStatusCode, &d.LastError,
+			&d.DeliveredAt, &d.CreatedAt, &d.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// ReplayDelivery puts a delivered or dead-lettered delivery back in the queue with a fresh attempt budget
+func (r *webhookRepository) ReplayDelivery(ctx context.Context, id int64) (bool, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW(),
+			last_error = NULL, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
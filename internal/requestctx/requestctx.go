@@ -0,0 +1,107 @@
+// Package requestctx carries per-request values, such as the request ID, the
+// acting user and a logger, from the HTTP layer down to services through context.Context.
+package requestctx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/tracing"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	actorKey
+	loggerKey
+)
+
+// ActorAnonymous is the actor of requests that did not identify one
+const ActorAnonymous = "anonymous"
+
+const maxRequestIDLength = 128
+
+// Begin returns a copy of ctx carrying the request ID and a log entry tagged with it,
+// the actor (anonymous until Authenticate) and the trace ID, when traced, along with
+// the request ID used. An invalid requestID is replaced with a new one. The HTTP and
+// gRPC servers call it with the caller's X-Request-ID.
+func Begin(ctx context.Context, requestID string, log *logrus.Logger) (context.Context, string) {
+	if !validRequestID(requestID) {
+		requestID = uuid.New().String()
+	}
+	ctx = WithRequestID(ctx, requestID)
+
+	entry := log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"actor":      Actor(ctx),
+	})
+	if fields := tracing.LogFields(ctx); fields != nil {
+		entry = entry.WithFields(fields)
+	}
+	return WithLogger(ctx, entry), requestID
+}
+
+// Authenticate returns a copy of ctx acting for actor, whose credentials the caller
+// has checked, with the request's log entry tagged with the new actor
+func Authenticate(ctx context.Context, actor string, log *logrus.Logger) context.Context {
+	ctx = WithActor(ctx, actor)
+	return WithLogger(ctx, Logger(ctx, log).WithField("actor", actor))
+}
+
+// validRequestID accepts short IDs of letters, digits and . _ : -, so a client
+// can't inject anything odd into logs
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == ':', r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID returns the request ID carried by ctx, or "" outside a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithActor returns a copy of ctx acting on behalf of actor: an authenticated
+// user, or "system:<job>" for background work
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor returns who ctx acts for, or ActorAnonymous if nobody was set
+func Actor(ctx context.Context) string {
+	if actor, _ := ctx.Value(actorKey).(string); actor != "" {
+		return actor
+	}
+	return ActorAnonymous
+}
+
+// WithLogger returns a copy of ctx carrying a log entry with the request's fields
+func WithLogger(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey, entry)
+}
+
+// Logger returns the log entry carried by ctx, or a plain entry of fallback outside
+// a request, so code shared with background jobs can log the same way
+func Logger(ctx context.Context, fallback *logrus.Logger) *logrus.Entry {
+	if entry, ok := ctx.Value(loggerKey).(*logrus.Entry); ok {
+		return entry
+	}
+	return logrus.NewEntry(fallback)
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+)
+
+// Audited actions
+const (
+	AuditRewardCreate          = "reward.create"
+	AuditRewardCreateOverride  = "reward.create_with_override"
+	AuditRewardInvalidate      = "reward.invalidate"
+	AuditRewardHold            = "reward.hold"
+	AuditRewardDeny            = "reward.deny"
+	AuditRewardReviewApprove   = "reward_review.approve"
+	AuditRewardReviewReject    = "reward_review.reject"
+	AuditHoldingSell           = "holding.sell"
+	AuditHoldingTransferOut    = "holding.transfer_out"
+	AuditHoldingRepair         = "holding.repair"
+	AuditCorporateActionCreate = "corporate_action.create"
+	AuditCorporateActionApply  = "corporate_action.apply"
+	AuditFeeScheduleCreate     = "fee_schedule.create"
+	AuditStockUpsert           = "stock.upsert"
+	AuditListingUpsert         = "stock_listing.upsert"
+	AuditBlocklistAdd          = "risk_blocklist.add"
+	AuditBlocklistRemove       = "risk_blocklist.remove"
+)
+
+// auditGenesisHash is the prev_hash of the first entry
+var auditGenesisHash = strings.Repeat("0", 64)
+
+// auditVerifyBatchSize is how many entries VerifyChain reads at a time
+const auditVerifyBatchSize = 1000
+
+var ErrInvalidAuditQuery = errors.New("invalid audit log query")
+
+// RecordAudit appends an entry for a change to the audit log. It must be called on a
+// repository bound to the transaction making the change, as late in it as possible:
+// appends are serialized, and the chain stays locked until the transaction ends.
+func RecordAudit(ctx context.Context, repo repository.AuditRepository, action, entityType, entityID string, before, after interface{}) error {
+	entry := &models.AuditEntry{
+		Actor:      requestctx.Actor(ctx),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		// Postgres keeps microseconds; truncate now so the hash matches what is stored
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	if requestID := requestctx.RequestID(ctx); requestID != "" {
+		entry.RequestID = sql.NullString{String: requestID, Valid: true}
+	}
+
+	var err error
+	if entry.Before, err = marshalAuditState(before); err != nil {
+		return fmt.Errorf("failed to encode audit state for %s: %w", action, err)
+	}
+	if entry.After, err = marshalAuditState(after); err != nil {
+		return fmt.Errorf("failed to encode audit state for %s: %w", action, err)
+	}
+
+	if err := repo.LockChain(ctx); err != nil {
+		return fmt.Errorf("failed to lock audit log: %w", err)
+	}
+	prev, err := repo.GetLastHash(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read audit log head: %w", err)
+	}
+	if prev == "" {
+		prev = auditGenesisHash
+	}
+	entry.PrevHash = prev
+	entry.Hash = auditHash(entry)
+
+	if err := repo.CreateEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+func marshalAuditState(state interface{}) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
+
+// auditHash is the SHA-256 of the entry's fields and the previous entry's hash. Each
+// field is length-prefixed so no two different entries hash the same input.
+func auditHash(e *models.AuditEntry) string {
+	h := sha256.New()
+	for _, field := range []string{
+		e.PrevHash,
+		e.Actor,
+		e.Action,
+		e.EntityType,
+		e.EntityID,
+		string(e.Before),
+		string(e.After),
+		e.RequestID.String,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	} {
+		fmt.Fprintf(h, "%d:%s\n", len(field), field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuditService reads the audit log and checks its hash chain
+type AuditService interface {
+	ListEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+	VerifyChain(ctx context.Context) (*AuditVerification, error)
+}
+
+// AuditVerification is the result of walking the whole chain. Deleting the newest
+// entries leaves a valid but shorter chain, so keep HeadHash somewhere outside the
+// database and compare it with a later run.
+type AuditVerification struct {
+	Valid          bool   `json:"valid"`
+	EntriesChecked int    `json:"entries_checked"`
+	HeadID         int64  `json:"head_id,omitempty"`
+	HeadHash       string `json:"head_hash,omitempty"`
+	BrokenAtID     int64  `json:"broken_at_id,omitempty"` // First entry that fails to verify
+	Problem        string `json:"problem,omitempty"`
+}
+
+type auditService struct {
+	auditRepo repository.AuditRepository
+	log       *logrus.Logger
+}
+
+func NewAuditService(auditRepo repository.AuditRepository, log *logrus.Logger) AuditService {
+	return &auditService{auditRepo: auditRepo, log: log}
+}
+
+func (s *auditService) ListEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Until.After(filter.Since) {
+		return nil, fmt.Errorf("%w: until must be after since", ErrInvalidAuditQuery)
+	}
+	return s.auditRepo.ListEntries(ctx, filter)
+}
+
+// VerifyChain recomputes every entry's hash and checks it links to the entry before it
+func (s *auditService) VerifyChain(ctx context.Context) (*AuditVerification, error) {
+	result := &AuditVerification{Valid: true}
+	prev := auditGenesisHash
+
+	for {
+		entries, err := s.auditRepo.ListEntries(ctx, models.AuditFilter{AfterID: result.HeadID, Limit: auditVerifyBatchSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit log: %w", err)
+		}
+
+		for i := range entries {
+			entry := &entries[i]
+			switch {
+			case entry.PrevHash != prev:
+				result.Problem = "prev_hash does not match the previous entry; an entry was removed or altered"
+			case auditHash(entry) != entry.Hash:
+				result.Problem = "hash does not match the entry's contents; the entry was altered"
+			}
+			if result.Problem != "" {
+				result.Valid = false
+				result.BrokenAtID = entry.ID
+				requestctx.Logger(ctx, s.log).WithFields(logrus.Fields{
+					"entry_id": entry.ID,
+					"problem":  result.Problem,
+				}).Error("Audit log chain is broken")
+				return result, nil
+			}
+
+			prev = entry.Hash
+			result.EntriesChecked++
+			result.HeadID = entry.ID
+			result.HeadHash = entry.Hash
+		}
+
+		if len(entries) < auditVerifyBatchSize {
+			return result, nil
+		}
+	}
+}
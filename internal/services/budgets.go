@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// Reward budget limits, as reported in BudgetBreach.Limit
+const (
+	BudgetPerReward   = "per_reward"
+	BudgetUserDaily   = "user_daily"
+	BudgetUserMonthly = "user_monthly"
+	BudgetReasonDaily = "reason_daily"
+	BudgetGlobalDaily = "global_daily"
+)
+
+var (
+	ErrBudgetExceeded        = errors.New("reward budget exceeded")
+	ErrInvalidBudgetOverride = errors.New("invalid budget override")
+)
+
+// BudgetExceededError is returned when a reward would exceed a budget. It matches ErrBudgetExceeded.
+type BudgetExceededError struct {
+	Breach models.BudgetBreach
+}
+
+func (e *BudgetExceededError) Error() string {
+	b := e.Breach
+	limit := b.Limit
+	if b.Limit == BudgetReasonDaily {
+		limit = fmt.Sprintf("%s (%s)", b.Limit, b.Reason)
+	}
+	return fmt.Sprintf("%v: %s limit %.2f INR, already spent %.2f, reward costs %.2f",
+		ErrBudgetExceeded, limit, b.LimitINR, b.SpentINR, b.RequestedINR)
+}
+
+func (e *BudgetExceededError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// RewardBudgetConfig caps reward spend in INR, measured on total_cost (value plus fees).
+// Days and months follow the IST calendar. Zero disables a limit.
+type RewardBudgetConfig struct {
+	MaxRewardINR   float64
+	UserDailyINR   float64
+	UserMonthlyINR float64
+	GlobalDailyINR float64
+	ReasonDailyINR map[string]float64 // Keyed by reward reason; reasons not listed are unlimited
+}
+
+// BudgetOverride lets an admin issue a reward over its budgets. It is recorded in reward_budget_overrides.
+type BudgetOverride struct {
+	ApprovedBy    string `json:"approved_by" binding:"required"`
+	Justification string `json:"justification" binding:"required"`
+}
+
+// checkRewardBudgets locks the budgets a reward is charged to and returns every
+// limit it would exceed. It must run in the transaction that inserts the reward,
+// so the spend it reads cannot change before the insert commits.
+func checkRewardBudgets(ctx context.Context, budgetRepo repository.BudgetRepository, config RewardBudgetConfig, event *models.RewardEvent, now time.Time) ([]models.BudgetBreach, error) {
+	reasonLimit := config.ReasonDailyINR[event.Reason]
+
+	// Always lock in the same order (global, reason, user) so concurrent rewards can't deadlock
+	var locks []string
+	if config.GlobalDailyINR > 0 {
+		locks = append(locks, "global")
+	}
+	if reasonLimit > 0 {
+		locks = append(locks, "reason:"+event.Reason)
+	}
+	if config.UserDailyINR > 0 || config.UserMonthlyINR > 0 {
+		locks = append(locks, "user:"+event.UserID)
+	}
+	for _, key := range locks {
+		if err := budgetRepo.LockBudget(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to lock reward budget: %w", err)
+		}
+	}
+
+	var breaches []models.BudgetBreach
+	cost := event.TotalCost
+	if config.MaxRewardINR > 0 && cost > config.MaxRewardINR {
+		breaches = append(breaches, models.BudgetBreach{Limit: BudgetPerReward, LimitINR: config.MaxRewardINR, RequestedINR: cost})
+	}
+	if len(locks) == 0 {
+		return breaches, nil
+	}
+
+	dayStart, monthStart := budgetWindows(now)
+	spend, err := budgetRepo.GetRewardSpend(ctx, event.UserID, event.Reason, dayStart, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reward spend: %w", err)
+	}
+
+	check := func(limit string, limitINR, spent float64) {
+		if limitINR > 0 && spent+cost > limitINR {
+			breach := models.BudgetBreach{Limit: limit, LimitINR: limitINR, SpentINR: spent, RequestedINR: cost}
+			if limit == BudgetReasonDaily {
+				breach.Reason = event.Reason
+			}
+			breaches = append(breaches, breach)
+		}
+	}
+	check(BudgetUserDaily, config.UserDailyINR, spend.UserDaily)
+	check(BudgetUserMonthly, config.UserMonthlyINR, spend.UserMonthly)
+	check(BudgetReasonDaily, reasonLimit, spend.ReasonDaily)
+	check(BudgetGlobalDaily, config.GlobalDailyINR, spend.GlobalDaily)
+
+	return breaches, nil
+}
+
+// budgetWindows returns the start of the IST day and month containing now, in UTC
+// to match created_at, which defaults to NOW() AT TIME ZONE 'UTC' (migration 014)
+func budgetWindows(now time.Time) (dayStart, monthStart time.Time) {
+	local := now.In(ist)
+	dayStart = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, ist).UTC()
+	monthStart = time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, ist).UTC()
+	return dayStart, monthStart
+}
+
+// enforceRewardBudgets fails with a BudgetExceededError for the first breach, unless
+// an override is given, in which case the breaches are recorded against the reward
+func enforceRewardBudgets(ctx context.Context, budgetRepo repository.BudgetRepository, config RewardBudgetConfig, event *models.RewardEvent, override *BudgetOverride, log *logrus.Logger) ([]models.BudgetBreach, error) {
+	breaches, err := checkRewardBudgets(ctx, budgetRepo, config, event, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(breaches) > 0 && override == nil {
+		metrics.RewardBudgetRejections.WithLabelValues(breaches[0].Limit).Inc()
+		return nil, &BudgetExceededError{Breach: breaches[0]}
+	}
+	if len(breaches) > 0 {
+		limits := make([]string, len(breaches))
+		for i, breach := range breaches {
+			limits[i] = breach.Limit
+		}
+		log.WithFields(logrus.Fields{
+			"user_id":     event.UserID,
+			"approved_by": override.ApprovedBy,
+			"limits":      strings.Join(limits, ","),
+		}).Warn("Reward budget overridden")
+	}
+	return breaches, nil
+}
+
+func validateBudgetOverride(override *BudgetOverride) error {
+	if override == nil {
+		return nil
+	}
+	override.ApprovedBy = strings.TrimSpace(override.ApprovedBy)
+	override.Justification = strings.TrimSpace(override.Justification)
+	if override.ApprovedBy == "" || override.Justification == "" {
+		return fmt.Errorf("%w: approved_by and justification are required", ErrInvalidBudgetOverride)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBudgetWindowsAreISTBoundariesInUTC(t *testing.T) {
+	// 20:00 UTC on 31 Jan is 01:30 IST on 1 Feb
+	now := time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC)
+
+	dayStart, monthStart := budgetWindows(now)
+	if want := time.Date(2025, 1, 31, 18, 30, 0, 0, time.UTC); !dayStart.Equal(want) || dayStart.Location() != time.UTC {
+		t.Errorf("dayStart = %s, want %s", dayStart, want)
+	}
+	if want := time.Date(2025, 1, 31, 18, 30, 0, 0, time.UTC); !monthStart.Equal(want) || monthStart.Location() != time.UTC {
+		t.Errorf("monthStart = %s, want %s", monthStart, want)
+	}
+
+	// The same instant seen from another zone gives the same windows
+	dayStart2, monthStart2 := budgetWindows(now.In(time.FixedZone("PST", -8*60*60)))
+	if !dayStart2.Equal(dayStart) || !monthStart2.Equal(monthStart) {
+		t.Errorf("windows depend on the zone of now: %s, %s", dayStart2, monthStart2)
+	}
+}
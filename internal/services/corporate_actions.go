@@ -0,0 +1,460 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Corporate action types, as stored in stock_events.event_type
+const (
+	CorporateActionSplit     = "split"
+	CorporateActionBonus     = "bonus"
+	CorporateActionMerger    = "merger"
+	CorporateActionDelisting = "delisting"
+)
+
+var (
+	ErrInvalidCorporateAction          = errors.New("invalid corporate action")
+	ErrCorporateActionNotFound         = errors.New("corporate action not found")
+	ErrCorporateActionAlreadyProcessed = errors.New("corporate action already processed")
+	ErrCorporateActionNotDue           = errors.New("corporate action not yet due")
+	ErrCorporateActionBlocked          = errors.New("corporate action blocked")
+)
+
+// errDryRun rolls back a transaction whose changes were only being previewed
+var errDryRun = errors.New("dry run")
+
+// CorporateActionRequest describes a stock event. For a split, RatioOld shares become
+// RatioNew shares. For a bonus issue, RatioNew bonus shares are issued for every
+// RatioOld held. For a merger, each share becomes ConversionRatio shares of MergedIntoSymbol.
+type CorporateActionRequest struct {
+	StockSymbol      string    `json:"stock_symbol"`
+	EventType        string    `json:"event_type"`
+	EventDate        time.Time `json:"event_date"`
+	RatioOld         int64     `json:"ratio_old,omitempty"`
+	RatioNew         int64     `json:"ratio_new,omitempty"`
+	MergedIntoSymbol string    `json:"merged_into_symbol,omitempty"`
+	ConversionRatio  float64   `json:"conversion_ratio,omitempty"`
+	Description      string    `json:"description,omitempty"`
+}
+
+// CorporateActionResult is what applying a stock event changed, or would change in a dry run
+type CorporateActionResult struct {
+	Event                    models.StockEvent                  `json:"event"`
+	DryRun                   bool                               `json:"dry_run"`
+	Adjustments              []models.CorporateActionAdjustment `json:"adjustments"`
+	TaxLotsAdjusted          int64                              `json:"tax_lots_adjusted"`
+	VestingSchedulesAdjusted int64                              `json:"vesting_schedules_adjusted"`
+}
+
+// CorporateActionAppliedData is the payload of the corporate_action.applied webhook
+type CorporateActionAppliedData struct {
+	Event            models.StockEvent `json:"event"`
+	HoldingsAdjusted int               `json:"holdings_adjusted"`
+}
+
+// CorporateActionService records stock events and applies them to holdings, tax lots
+// and vesting schedules
+type CorporateActionService interface {
+	CreateAction(ctx context.Context, req *CorporateActionRequest, dryRun bool) (*models.StockEvent, error)
+	ListActions(ctx context.Context, pendingOnly bool) ([]models.StockEvent, error)
+	ApplyAction(ctx context.Context, id int64, dryRun bool) (*CorporateActionResult, error)
+	ApplyDueActions(ctx context.Context, dryRun bool) ([]CorporateActionResult, error)
+}
+
+type corporateActionService struct {
+	txManager           repository.TxManager
+	corporateActionRepo repository.CorporateActionRepository
+	rewardRepo          repository.RewardRepository
+	stockRepo           repository.StockRepository
+	vestingRepo         repository.VestingRepository
+	webhookRepo         repository.WebhookRepository
+	auditRepo           repository.AuditRepository
+	log                 *logrus.Logger
+}
+
+func NewCorporateActionService(
+	txManager repository.TxManager,
+	corporateActionRepo repository.CorporateActionRepository,
+	rewardRepo repository.RewardRepository,
+	stockRepo repository.StockRepository,
+	vestingRepo repository.VestingRepository,
+	webhookRepo repository.WebhookRepository,
+	auditRepo repository.AuditRepository,
+	log *logrus.Logger,
+) CorporateActionService {
+	return &corporateActionService{
+		txManager:           txManager,
+		corporateActionRepo: corporateActionRepo,
+		rewardRepo:          rewardRepo,
+		stockRepo:           stockRepo,
+		vestingRepo:         vestingRepo,
+		webhookRepo:         webhookRepo,
+		auditRepo:           auditRepo,
+		log:                 log,
+	}
+}
+
+// CreateAction validates and records a stock event. A dry run only validates it.
+func (s *corporateActionService) CreateAction(ctx context.Context, req *CorporateActionRequest, dryRun bool) (_ *models.StockEvent, err error) {
+	ctx, span := tracer.Start(ctx, "CorporateActionService.CreateAction", trace.WithAttributes(attribute.String("stock_symbol", req.StockSymbol), attribute.Bool("dry_run", dryRun)))
+	defer func() { tracing.End(span, err) }()
+
+	event, err := buildStockEvent(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.stockRepo.GetStockBySymbol(ctx, event.StockSymbol); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCorporateAction, err)
+	}
+	if event.MergedIntoSymbol.Valid {
+		if _, err := s.stockRepo.GetStockBySymbol(ctx, event.MergedIntoSymbol.String); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCorporateAction, err)
+		}
+	}
+
+	if dryRun {
+		return event, nil
+	}
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		if err := s.corporateActionRepo.WithTx(tx).CreateStockEvent(ctx, event); err != nil {
+			return fmt.Errorf("failed to create stock event: %w", err)
+		}
+		return RecordAudit(ctx, s.auditRepo.WithTx(tx), AuditCorporateActionCreate, "stock_event", strconv.FormatInt(event.ID, 10), nil, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (s *corporateActionService) ListActions(ctx context.Context, pendingOnly bool) ([]models.StockEvent, error) {
+	return s.corporateActionRepo.ListStockEvents(ctx, pendingOnly)
+}
+
+// ApplyDueActions applies every unprocessed event dated today or earlier, oldest first.
+// It stops at the first failure, since later events may depend on earlier ones. In a
+// dry run each event is previewed against the current state, not after its predecessors.
+func (s *corporateActionService) ApplyDueActions(ctx context.Context, dryRun bool) ([]CorporateActionResult, error) {
+	events, err := s.corporateActionRepo.ListStockEvents(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending stock events: %w", err)
+	}
+
+	now := time.Now()
+	var results []CorporateActionResult
+	for _, event := range events {
+		if event.EventDate.After(now) {
+			break
+		}
+		result, err := s.ApplyAction(ctx, event.ID, dryRun)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// ApplyAction applies one stock event in a single transaction: every holding of the
+// stock is locked and adjusted, open tax lots and vesting schedules are scaled to match,
+// the event is marked processed and corporate_action.applied is written to the outbox.
+// A dry run does all of this and rolls it back.
+func (s *corporateActionService) ApplyAction(ctx context.Context, id int64, dryRun bool) (_ *CorporateActionResult, err error) {
+	ctx, span := tracer.Start(ctx, "CorporateActionService.ApplyAction", trace.WithAttributes(attribute.Int64("stock_event_id", id), attribute.Bool("dry_run", dryRun)))
+	defer func() { tracing.End(span, err) }()
+
+	result := &CorporateActionResult{DryRun: dryRun}
+
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		repo := s.corporateActionRepo.WithTx(tx)
+
+		event, err := repo.GetStockEventForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to load stock event: %w", err)
+		}
+		if event == nil {
+			return fmt.Errorf("%w: %d", ErrCorporateActionNotFound, id)
+		}
+		if event.Processed {
+			return fmt.Errorf("%w: %d", ErrCorporateActionAlreadyProcessed, id)
+		}
+		if event.EventDate.After(time.Now()) {
+			return fmt.Errorf("%w: %d takes effect on %s", ErrCorporateActionNotDue, id, event.EventDate.Format("2006-01-02"))
+		}
+		before := *event
+
+		switch event.EventType {
+		case CorporateActionSplit, CorporateActionBonus:
+			err = s.applySplit(ctx, tx, event, result)
+		case CorporateActionMerger:
+			err = s.applyMerger(ctx, tx, event, result)
+		case CorporateActionDelisting:
+			err = repo.SetStockActive(ctx, event.StockSymbol, false)
+		default:
+			err = fmt.Errorf("%w: unknown event type %q", ErrInvalidCorporateAction, event.EventType)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := repo.MarkProcessed(ctx, event.ID); err != nil {
+			return fmt.Errorf("failed to mark stock event processed: %w", err)
+		}
+		event.Processed = true
+		event.ProcessedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		result.Event = *event
+
+		data := CorporateActionAppliedData{Event: *event, HoldingsAdjusted: len(result.Adjustments)}
+		if err := enqueueOutboxEvent(ctx, s.webhookRepo.WithTx(tx), EventCorporateActionApplied, "stock_event", strconv.FormatInt(event.ID, 10), data); err != nil {
+			return err
+		}
+		if err := RecordAudit(ctx, s.auditRepo.WithTx(tx), AuditCorporateActionApply, "stock_event", strconv.FormatInt(event.ID, 10), before, result); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	if !dryRun {
+		requestctx.Logger(ctx, s.log).Infof("Corporate action %d applied: type=%s, stock=%s, holdings=%d, tax lots=%d, vesting schedules=%d",
+			result.Event.ID, result.Event.EventType, result.Event.StockSymbol, len(result.Adjustments),
+			result.TaxLotsAdjusted, result.VestingSchedulesAdjusted)
+	}
+
+	return result, nil
+}
+
+// applySplit multiplies every holding by the split (or bonus) factor and divides its
+// average price by it, so each holding's cost basis is unchanged
+func (s *corporateActionService) applySplit(ctx context.Context, tx *sql.Tx, event *models.StockEvent, result *CorporateActionResult) error {
+	factor, err := shareFactor(event)
+	if err != nil {
+		return err
+	}
+	repo := s.corporateActionRepo.WithTx(tx)
+
+	holdings, err := repo.GetHoldingsForUpdate(ctx, event.StockSymbol)
+	if err != nil {
+		return fmt.Errorf("failed to lock holdings: %w", err)
+	}
+
+	for _, holding := range holdings {
+		adjustment := models.CorporateActionAdjustment{
+			StockEventID:         event.ID,
+			UserID:               holding.UserID,
+			StockSymbol:          holding.StockSymbol,
+			Kind:                 MovementSplit,
+			ShareFactor:          factor,
+			PreviousShares:       holding.TotalShares,
+			PreviousAveragePrice: holding.AveragePrice,
+			NewShares:            roundToDecimal(holding.TotalShares*factor, 6),
+			NewAveragePrice:      roundToDecimal(holding.AveragePrice/factor, 4),
+		}
+		if err := s.adjustHolding(ctx, tx, &adjustment); err != nil {
+			return err
+		}
+		result.Adjustments = append(result.Adjustments, adjustment)
+	}
+
+	if result.TaxLotsAdjusted, err = repo.ScaleTaxLots(ctx, event.StockSymbol, event.StockSymbol, factor); err != nil {
+		return fmt.Errorf("failed to scale tax lots: %w", err)
+	}
+	if result.VestingSchedulesAdjusted, err = repo.ScaleVestingSchedules(ctx, event.StockSymbol, factor); err != nil {
+		return fmt.Errorf("failed to scale vesting schedules: %w", err)
+	}
+	return nil
+}
+
+// applyMerger converts every holding of the merged stock into the surviving stock,
+// carrying the cost basis over, and deactivates the merged stock. Mergers are refused
+// while any shares of the merged stock are still vesting, because forfeiture
+// claws shares back from the stock the reward was originally made in.
+func (s *corporateActionService) applyMerger(ctx context.Context, tx *sql.Tx, event *models.StockEvent, result *CorporateActionResult) error {
+	if !event.MergedIntoSymbol.Valid || !event.ConversionRatio.Valid || event.ConversionRatio.Float64 <= 0 {
+		return fmt.Errorf("%w: merger %d needs merged_into_symbol and a positive conversion_ratio", ErrInvalidCorporateAction, event.ID)
+	}
+	target := event.MergedIntoSymbol.String
+	ratio := event.ConversionRatio.Float64
+
+	stock, err := s.stockRepo.GetStockBySymbol(ctx, target)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCorporateAction, err)
+	}
+	if !stock.IsActive {
+		return fmt.Errorf("%w: %s is not active", ErrInvalidCorporateAction, target)
+	}
+
+	schedules, err := s.vestingRepo.WithTx(tx).GetActiveSchedulesBySymbol(ctx, event.StockSymbol)
+	if err != nil {
+		return fmt.Errorf("failed to load vesting schedules: %w", err)
+	}
+	now := time.Now()
+	for i := range schedules {
+		if UnvestedQuantity(&schedules[i], now) > 0 {
+			return fmt.Errorf("%w: %s still has unvested shares (vesting schedule %d)", ErrCorporateActionBlocked, event.StockSymbol, schedules[i].ID)
+		}
+	}
+
+	repo := s.corporateActionRepo.WithTx(tx)
+	holdings, err := repo.GetHoldingsForUpdate(ctx, event.StockSymbol)
+	if err != nil {
+		return fmt.Errorf("failed to lock holdings: %w", err)
+	}
+
+	for _, holding := range holdings {
+		converted := roundToDecimal(holding.TotalShares*ratio, 6)
+
+		out := models.CorporateActionAdjustment{
+			StockEventID:         event.ID,
+			UserID:               holding.UserID,
+			StockSymbol:          holding.StockSymbol,
+			Kind:                 MovementMergerOut,
+			ShareFactor:          ratio,
+			Quantity:             holding.TotalShares,
+			PreviousShares:       holding.TotalShares,
+			PreviousAveragePrice: holding.AveragePrice,
+			NewShares:            0,
+			NewAveragePrice:      holding.AveragePrice,
+		}
+		if err := s.adjustHolding(ctx, tx, &out); err != nil {
+			return err
+		}
+		result.Adjustments = append(result.Adjustments, out)
+
+		if converted <= 0 {
+			continue // Too small to survive the conversion
+		}
+
+		existing, err := s.rewardRepo.WithTx(tx).GetUserHoldingForUpdate(ctx, holding.UserID, target)
+		if err != nil {
+			return fmt.Errorf("failed to lock holding: %w", err)
+		}
+		previous := derefHolding(existing)
+
+		in := models.CorporateActionAdjustment{
+			StockEventID:         event.ID,
+			UserID:               holding.UserID,
+			StockSymbol:          target,
+			Kind:                 MovementMergerIn,
+			ShareFactor:          ratio,
+			Quantity:             converted,
+			PricePerShare:        roundToDecimal(holding.TotalShares*holding.AveragePrice/converted, 4),
+			PreviousShares:       previous.TotalShares,
+			PreviousAveragePrice: previous.AveragePrice,
+		}
+		// Blend exactly as a reward would, so reconciliation replays it the same way
+		totalCost := previous.TotalShares*previous.AveragePrice + in.Quantity*in.PricePerShare
+		in.NewShares = roundToDecimal(previous.TotalShares+in.Quantity, 6)
+		in.NewAveragePrice = roundToDecimal(totalCost/in.NewShares, 4)
+		if err := s.adjustHolding(ctx, tx, &in); err != nil {
+			return err
+		}
+		result.Adjustments = append(result.Adjustments, in)
+	}
+
+	if result.TaxLotsAdjusted, err = repo.ScaleTaxLots(ctx, event.StockSymbol, target, ratio); err != nil {
+		return fmt.Errorf("failed to convert tax lots: %w", err)
+	}
+	if err := repo.SetStockActive(ctx, event.StockSymbol, false); err != nil {
+		return fmt.Errorf("failed to deactivate %s: %w", event.StockSymbol, err)
+	}
+	return nil
+}
+
+// adjustHolding writes the adjusted holding and its adjustment record
+func (s *corporateActionService) adjustHolding(ctx context.Context, tx *sql.Tx, adjustment *models.CorporateActionAdjustment) error {
+	holding := &models.UserHolding{
+		UserID:       adjustment.UserID,
+		StockSymbol:  adjustment.StockSymbol,
+		TotalShares:  adjustment.NewShares,
+		AveragePrice: adjustment.NewAveragePrice,
+		LastUpdated:  time.Now(),
+	}
+	if err := s.rewardRepo.WithTx(tx).UpsertUserHolding(ctx, holding); err != nil {
+		return fmt.Errorf("failed to update holding: %w", err)
+	}
+	if err := s.corporateActionRepo.WithTx(tx).CreateAdjustment(ctx, adjustment); err != nil {
+		return fmt.Errorf("failed to record adjustment: %w", err)
+	}
+	return nil
+}
+
+// shareFactor is how many shares each share becomes. It is rounded to the precision
+// stored in corporate_action_adjustments so live updates and replays agree.
+func shareFactor(event *models.StockEvent) (float64, error) {
+	if !event.SplitRatioOld.Valid || !event.SplitRatioNew.Valid ||
+		event.SplitRatioOld.Int64 <= 0 || event.SplitRatioNew.Int64 <= 0 {
+		return 0, fmt.Errorf("%w: %s %d needs positive split_ratio_old and split_ratio_new", ErrInvalidCorporateAction, event.EventType, event.ID)
+	}
+
+	held, issued := float64(event.SplitRatioOld.Int64), float64(event.SplitRatioNew.Int64)
+	if event.EventType == CorporateActionBonus {
+		return roundToDecimal((held+issued)/held, 6), nil
+	}
+	return roundToDecimal(issued/held, 6), nil
+}
+
+func buildStockEvent(req *CorporateActionRequest) (*models.StockEvent, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(req.StockSymbol))
+	if symbol == "" {
+		return nil, fmt.Errorf("%w: stock_symbol is required", ErrInvalidCorporateAction)
+	}
+	if req.EventDate.IsZero() {
+		return nil, fmt.Errorf("%w: event_date is required", ErrInvalidCorporateAction)
+	}
+
+	event := &models.StockEvent{
+		StockSymbol: symbol,
+		EventType:   req.EventType,
+		EventDate:   req.EventDate,
+		Description: req.Description,
+	}
+
+	switch req.EventType {
+	case CorporateActionSplit, CorporateActionBonus:
+		if req.RatioOld <= 0 || req.RatioNew <= 0 {
+			return nil, fmt.Errorf("%w: %s needs a positive ratio", ErrInvalidCorporateAction, req.EventType)
+		}
+		if req.EventType == CorporateActionSplit && req.RatioOld == req.RatioNew {
+			return nil, fmt.Errorf("%w: a %d:%d split changes nothing", ErrInvalidCorporateAction, req.RatioOld, req.RatioNew)
+		}
+		event.SplitRatioOld = sql.NullInt64{Int64: req.RatioOld, Valid: true}
+		event.SplitRatioNew = sql.NullInt64{Int64: req.RatioNew, Valid: true}
+	case CorporateActionMerger:
+		target := strings.ToUpper(strings.TrimSpace(req.MergedIntoSymbol))
+		if target == "" || target == symbol {
+			return nil, fmt.Errorf("%w: merger needs a different merged_into_symbol", ErrInvalidCorporateAction)
+		}
+		if req.ConversionRatio <= 0 {
+			return nil, fmt.Errorf("%w: merger needs a positive conversion_ratio", ErrInvalidCorporateAction)
+		}
+		event.MergedIntoSymbol = sql.NullString{String: target, Valid: true}
+		event.ConversionRatio = sql.NullFloat64{Float64: req.ConversionRatio, Valid: true}
+	case CorporateActionDelisting:
+	default:
+		return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidCorporateAction, req.EventType)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	DisposalSell        = "sell"
+	DisposalTransferOut = "transfer_out"
+)
+
+// quantityEpsilon absorbs float noise below the 6-decimal share precision
+const quantityEpsilon = 1e-9
+
+// ErrInsufficientShares is returned when a disposal exceeds the settled, vested quantity
+var ErrInsufficientShares = errors.New("insufficient available shares")
+
+// DisposalService handles user-initiated sales and transfers of rewarded shares
+type DisposalService interface {
+	Sell(ctx context.Context, req *SellRequest) (*models.Disposal, error)
+	TransferOut(ctx context.Context, req *TransferOutRequest) (*models.Disposal, error)
+	GetUserDisposals(ctx context.Context, userID string) ([]models.Disposal, error)
+}
+
+type SellRequest struct {
+	IdempotencyKey string  `json:"idempotency_key" binding:"required"`
+	UserID         string  `json:"user_id" binding:"required"`
+	StockSymbol    string  `json:"stock_symbol" binding:"required"`
+	Quantity       float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+type TransferOutRequest struct {
+	IdempotencyKey string  `json:"idempotency_key" binding:"required"`
+	UserID         string  `json:"user_id" binding:"required"`
+	StockSymbol    string  `json:"stock_symbol" binding:"required"`
+	Quantity       float64 `json:"quantity" binding:"required,gt=0"`
+	DematAccount   string  `json:"demat_account" binding:"required"`
+}
+
+type DisposalConfig struct {
+	// SettlementPeriod is how long after the reward date shares become sellable
+	SettlementPeriod time.Duration
+}
+
+type disposalService struct {
+	txManager    repository.TxManager
+	rewardRepo   repository.RewardRepository
+	taxLotRepo   repository.TaxLotRepository
+	disposalRepo repository.DisposalRepository
+	vestingRepo  repository.VestingRepository
+	ledgerRepo   repository.LedgerRepository
+	priceService StockPriceService
+	feeService   FeeScheduleService
+	auditRepo    repository.AuditRepository
+	config       DisposalConfig
+	log          *logrus.Logger
+}
+
+func NewDisposalService(
+	txManager repository.TxManager,
+	rewardRepo repository.RewardRepository,
+	taxLotRepo repository.TaxLotRepository,
+	disposalRepo repository.DisposalRepository,
+	vestingRepo repository.VestingRepository,
+	ledgerRepo repository.LedgerRepository,
+	priceService StockPriceService,
+	feeService FeeScheduleService,
+	auditRepo repository.AuditRepository,
+	config DisposalConfig,
+	log *logrus.Logger,
+) DisposalService {
+	return &disposalService{
+		txManager:    txManager,
+		rewardRepo:   rewardRepo,
+		taxLotRepo:   taxLotRepo,
+		disposalRepo: disposalRepo,
+		vestingRepo:  vestingRepo,
+		ledgerRepo:   ledgerRepo,
+		priceService: priceService,
+		feeService:   feeService,
+		auditRepo:    auditRepo,
+		config:       config,
+		log:          log,
+	}
+}
+
+func (s *disposalService) Sell(ctx context.Context, req *SellRequest) (*models.Disposal, error) {
+	disposal := &models.Disposal{
+		IdempotencyKey: req.IdempotencyKey,
+		UserID:         req.UserID,
+		StockSymbol:    req.StockSymbol,
+		DisposalType:   DisposalSell,
+		Quantity:       roundToDecimal(req.Quantity, 6),
+	}
+	return s.dispose(ctx, disposal)
+}
+
+func (s *disposalService) TransferOut(ctx context.Context, req *TransferOutRequest) (*models.Disposal, error) {
+	disposal := &models.Disposal{
+		IdempotencyKey: req.IdempotencyKey,
+		UserID:         req.UserID,
+		StockSymbol:    req.StockSymbol,
+		DisposalType:   DisposalTransferOut,
+		Quantity:       roundToDecimal(req.Quantity, 6),
+		DematAccount:   sql.NullString{String: req.DematAccount, Valid: true},
+	}
+	return s.dispose(ctx, disposal)
+}
+
+func (s *disposalService) GetUserDisposals(ctx context.Context, userID string) ([]models.Disposal, error) {
+	return s.disposalRepo.GetUserDisposals(ctx, userID)
+}
+
+func (s *disposalService) dispose(ctx context.Context, disposal *models.Disposal) (_ *models.Disposal, err error) {
+	ctx, span := tracer.Start(ctx, "DisposalService.Dispose", trace.WithAttributes(attribute.String("disposal_type", disposal.DisposalType), attribute.String("user_id", disposal.UserID), attribute.String("stock_symbol", disposal.StockSymbol)))
+	defer func() { tracing.End(span, err) }()
+
+	// Check idempotency
+	existing, err := s.disposalRepo.GetDisposalByIdempotencyKey(ctx, disposal.IdempotencyKey)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency check failed: %w", err)
+	}
+	if existing != nil {
+		requestctx.Logger(ctx, s.log).Infof("Duplicate disposal request detected (key: %s), returning existing disposal", disposal.IdempotencyKey)
+		return existing, nil
+	}
+
+	price, err := s.priceService.GetCurrentPrice(ctx, disposal.StockSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock price: %w", err)
+	}
+
+	now := time.Now()
+	disposal.PricePerShare = price
+	disposal.DisposedAt = now
+
+	// Transfers to the user's own demat account carry no trade fees or proceeds
+	if disposal.DisposalType == DisposalSell {
+		schedule, err := s.feeService.GetEffectiveSchedule(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+		gross := disposal.Quantity * price
+		fees := calculateFees(schedule, gross, TradeSell)
+
+		disposal.GrossProceeds = roundToDecimal(gross, 4)
+		disposal.BrokerageFee = fees.Brokerage
+		disposal.STTFee = fees.STT
+		disposal.GSTFee = fees.GST
+		disposal.ExchangeFee = fees.Exchange
+		disposal.SEBIFee = fees.SEBI
+		disposal.TotalFees = fees.Total
+		disposal.NetProceeds = roundToDecimal(disposal.GrossProceeds-fees.Total, 4)
+		disposal.FeeScheduleVersion = sql.NullInt64{Int64: int64(schedule.Version), Valid: true}
+	}
+
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		// Lock the holding first so concurrent disposals for the same
+		// user and stock serialise here
+		holding, err := s.rewardRepo.WithTx(tx).GetUserHoldingForUpdate(ctx, disposal.UserID, disposal.StockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to lock holding: %w", err)
+		}
+		if holding == nil {
+			return fmt.Errorf("%w: no %s holding for user %s", ErrInsufficientShares, disposal.StockSymbol, disposal.UserID)
+		}
+
+		lots, err := s.taxLotRepo.WithTx(tx).GetOpenLotsForUpdate(ctx, disposal.UserID, disposal.StockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to lock tax lots: %w", err)
+		}
+
+		schedules, err := s.vestingRepo.WithTx(tx).GetActiveSchedulesForStock(ctx, disposal.UserID, disposal.StockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to load vesting schedules: %w", err)
+		}
+
+		allocations := s.availableLots(lots, schedules, now)
+		available := math.Min(sumAvailable(allocations), holding.TotalShares)
+		if disposal.Quantity > available+quantityEpsilon {
+			return fmt.Errorf("%w: requested %.6f, available %.6f", ErrInsufficientShares, disposal.Quantity, available)
+		}
+
+		if err := s.disposalRepo.WithTx(tx).CreateDisposal(ctx, disposal); err != nil {
+			return fmt.Errorf("failed to create disposal: %w", err)
+		}
+
+		if err := s.consumeLots(ctx, tx, disposal, allocations); err != nil {
+			return err
+		}
+
+		if err := s.rewardRepo.WithTx(tx).DecrementUserHolding(ctx, disposal.UserID, disposal.StockSymbol, disposal.Quantity); err != nil {
+			return fmt.Errorf("failed to update holding: %w", err)
+		}
+
+		if err := s.ledgerRepo.WithTx(tx).CreateLedgerEntries(ctx, disposalLedgerEntries(disposal)); err != nil {
+			return fmt.Errorf("failed to create ledger entries: %w", err)
+		}
+
+		action := AuditHoldingSell
+		if disposal.DisposalType == DisposalTransferOut {
+			action = AuditHoldingTransferOut
+		}
+		return RecordAudit(ctx, s.auditRepo.WithTx(tx), action, "disposal", strconv.FormatInt(disposal.ID, 10), holding, disposal)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requestctx.Logger(ctx, s.log).Infof("Disposal created: type=%s, user=%s, stock=%s, quantity=%.6f, price=%.2f, net_proceeds=%.2f",
+		disposal.DisposalType, disposal.UserID, disposal.StockSymbol, disposal.Quantity, disposal.PricePerShare, disposal.NetProceeds)
+
+	return disposal, nil
+}
+
+// lotAllocation is the part of a lot that may be disposed of right now
+type lotAllocation struct {
+	lot       models.TaxLot
+	available float64
+}
+
+// availableLots keeps settled lots and subtracts each lot's unvested shares
+func (s *disposalService) availableLots(lots []models.TaxLot, schedules []models.VestingSchedule, now time.Time) []lotAllocation {
+	cutoff := now.Add(-s.config.SettlementPeriod)
+
+	locked := make(map[int64]float64, len(schedules))
+	for i := range schedules {
+		locked[schedules[i].RewardEventID] = UnvestedQuantity(&schedules[i], now)
+	}
+
+	var allocations []lotAllocation
+	for _, lot := range lots {
+		if lot.AcquiredAt.After(cutoff) {
+			continue
+		}
+		available := roundToDecimal(lot.RemainingQuantity-locked[lot.RewardEventID], 6)
+		if available > 0 {
+			allocations = append(allocations, lotAllocation{lot: lot, available: available})
+		}
+	}
+	return allocations
+}
+
+func sumAvailable(allocations []lotAllocation) float64 {
+	total := 0.0
+	for _, a := range allocations {
+		total += a.available
+	}
+	return total
+}
+
+// consumeLots draws the disposal quantity from lots FIFO and records each draw
+func (s *disposalService) consumeLots(ctx context.Context, tx *sql.Tx, disposal *models.Disposal, allocations []lotAllocation) error {
+	taxLotRepo := s.taxLotRepo.WithTx(tx)
+	left := disposal.Quantity
+
+	for _, a := range allocations {
+		if left <= quantityEpsilon {
+			break
+		}
+
+		lot := a.lot
+		take := roundToDecimal(math.Min(a.available, left), 6)
+		if take <= 0 {
+			continue
+		}
+
+		if err := taxLotRepo.ConsumeLot(ctx, lot.ID, take); err != nil {
+			return fmt.Errorf("failed to consume tax lot %d: %w", lot.ID, err)
+		}
+
+		lotDisposal := &models.LotDisposal{
+			TaxLotID:         lot.ID,
+			DisposalID:       sql.NullInt64{Int64: disposal.ID, Valid: true},
+			UserID:           disposal.UserID,
+			StockSymbol:      disposal.StockSymbol,
+			Quantity:         take,
+			AcquiredAt:       lot.AcquiredAt,
+			DisposedAt:       disposal.DisposedAt,
+			CostPerShare:     lot.CostPerShare,
+			ProceedsPerShare: disposal.PricePerShare,
+		}
+		if err := taxLotRepo.CreateLotDisposal(ctx, lotDisposal); err != nil {
+			return fmt.Errorf("failed to record lot disposal: %w", err)
+		}
+
+		left -= take
+	}
+
+	return nil
+}
+
+// disposalLedgerEntries builds a balanced entry group for a disposal.
+// Zero-amount lines are omitted because the ledger rejects them.
+func disposalLedgerEntries(d *models.Disposal) []models.LedgerEntry {
+	groupID := uuid.New().String()
+	disposalID := sql.NullInt64{Int64: d.ID, Valid: true}
+	symbol := sql.NullString{String: d.StockSymbol, Valid: true}
+
+	entry := func(account string, stock sql.NullString, debit, credit float64, description string) models.LedgerEntry {
+		return models.LedgerEntry{
+			EntryGroupID: groupID,
+			DisposalID:   disposalID,
+			AccountType:  account,
+			StockSymbol:  stock,
+			DebitAmount:  roundToDecimal(debit, 4),
+			CreditAmount: roundToDecimal(credit, 4),
+			Description:  description,
+		}
+	}
+
+	var entries []models.LedgerEntry
+	if d.DisposalType == DisposalTransferOut {
+		value := d.Quantity * d.PricePerShare
+		entries = []models.LedgerEntry{
+			entry("stock_transfer_out", symbol, value, 0,
+				fmt.Sprintf("Transfer: %s x %.6f shares to demat %s for user %s", d.StockSymbol, d.Quantity, d.DematAccount.String, d.UserID)),
+			entry("stock_inventory", symbol, 0, value, "Shares released from custody"),
+		}
+	} else {
+		entries = []models.LedgerEntry{
+			// Shares leave custody in exchange for sale proceeds
+			entry("cash_inflow", sql.NullString{}, d.GrossProceeds, 0,
+				fmt.Sprintf("Sale: %s x %.6f shares for user %s", d.StockSymbol, d.Quantity, d.UserID)),
+			entry("stock_inventory", symbol, 0, d.GrossProceeds, "Shares sold"),
+			// Proceeds paid out: net to the user, fees to broker, exchange and government
+			entry("user_payout", sql.NullString{}, d.NetProceeds, 0, "Net sale proceeds paid to user"),
+			entry("fees_passthrough", sql.NullString{}, d.TotalFees, 0,
+				fmt.Sprintf("Sell fees: brokerage=%.2f, STT=%.2f, GST=%.2f, exchange=%.2f, SEBI=%.2f", d.BrokerageFee, d.STTFee, d.GSTFee, d.ExchangeFee, d.SEBIFee)),
+			entry("cash_outflow", sql.NullString{}, 0, d.GrossProceeds, "Cash paid for sale proceeds and fees"),
+		}
+	}
+
+	filtered := entries[:0]
+	for _, e := range entries {
+		if e.DebitAmount > 0 || e.CreditAmount > 0 {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+)
+
+const (
+	BrokerageFlat    = "flat"
+	BrokeragePercent = "percent"
+
+	TradeBuy  = "buy"
+	TradeSell = "sell"
+)
+
+// FeeScheduleService resolves and manages versioned fee schedules
+type FeeScheduleService interface {
+	GetEffectiveSchedule(ctx context.Context, at time.Time) (*models.FeeSchedule, error)
+	ListSchedules(ctx context.Context) ([]models.FeeSchedule, error)
+	CreateSchedule(ctx context.Context, req *FeeScheduleRequest) (*models.FeeSchedule, error)
+}
+
+type FeeScheduleRequest struct {
+	EffectiveFrom  time.Time              `json:"effective_from" binding:"required"`
+	BrokerageSlabs []models.BrokerageSlab `json:"brokerage_slabs" binding:"required"`
+	STTBP          float64                `json:"stt_bp"`
+	ExchangeBP     float64                `json:"exchange_bp"`
+	SEBIBP         float64                `json:"sebi_bp"`
+	StampDutyBP    float64                `json:"stamp_duty_bp"`
+	GSTPct         float64                `json:"gst_pct"`
+	Description    string                 `json:"description"`
+}
+
+type feeScheduleService struct {
+	txManager repository.TxManager
+	feeRepo   repository.FeeScheduleRepository
+	auditRepo repository.AuditRepository
+	fallback  *models.FeeSchedule
+	log       *logrus.Logger
+}
+
+// NewFeeScheduleService creates the service. The env-configured fees are
+// used as version 0 when no database schedule is in effect yet.
+func NewFeeScheduleService(
+	txManager repository.TxManager,
+	feeRepo repository.FeeScheduleRepository,
+	auditRepo repository.AuditRepository,
+	fallback FeesConfig,
+	log *logrus.Logger,
+) FeeScheduleService {
+	return &feeScheduleService{
+		txManager: txManager,
+		feeRepo:   feeRepo,
+		auditRepo: auditRepo,
+		fallback:  fallback.toSchedule(),
+		log:       log,
+	}
+}
+
+// toSchedule converts the legacy flat basis-point config into a schedule
+func (c FeesConfig) toSchedule() *models.FeeSchedule {
+	return &models.FeeSchedule{
+		Version: 0,
+		BrokerageSlabs: []models.BrokerageSlab{
+			{Type: BrokeragePercent, RateBP: float64(c.BrokerageFeeBP)},
+		},
+		STTBP:       float64(c.STTFeeBP),
+		ExchangeBP:  float64(c.ExchangeFeeBP),
+		SEBIBP:      float64(c.SEBIFeeBP),
+		GSTPct:      float64(c.GSTFeePercent),
+		Description: "Environment-configured fallback",
+	}
+}
+
+func (s *feeScheduleService) GetEffectiveSchedule(ctx context.Context, at time.Time) (*models.FeeSchedule, error) {
+	schedule, err := s.feeRepo.GetEffectiveFeeSchedule(ctx, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fee schedule: %w", err)
+	}
+	if schedule == nil {
+		requestctx.Logger(ctx, s.log).Warnf("No fee schedule effective at %s, using environment fallback", at.Format(time.RFC3339))
+		return s.fallback, nil
+	}
+	return schedule, nil
+}
+
+func (s *feeScheduleService) ListSchedules(ctx context.Context) ([]models.FeeSchedule, error) {
+	return s.feeRepo.ListFeeSchedules(ctx)
+}
+
+func (s *feeScheduleService) CreateSchedule(ctx context.Context, req *FeeScheduleRequest) (_ *models.FeeSchedule, err error) {
+	ctx, span := tracer.Start(ctx, "FeeScheduleService.CreateSchedule")
+	defer func() { tracing.End(span, err) }()
+
+	schedule := &models.FeeSchedule{
+		EffectiveFrom:  req.EffectiveFrom,
+		BrokerageSlabs: req.BrokerageSlabs,
+		STTBP:          req.STTBP,
+		ExchangeBP:     req.ExchangeBP,
+		SEBIBP:         req.SEBIBP,
+		StampDutyBP:    req.StampDutyBP,
+		GSTPct:         req.GSTPct,
+		Description:    req.Description,
+	}
+
+	if err := ValidateFeeSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		if err := s.feeRepo.WithTx(tx).CreateFeeSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("failed to create fee schedule: %w", err)
+		}
+		return RecordAudit(ctx, s.auditRepo.WithTx(tx), AuditFeeScheduleCreate, "fee_schedule", strconv.Itoa(schedule.Version), nil, schedule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requestctx.Logger(ctx, s.log).Infof("Fee schedule v%d created (effective from %s)", schedule.Version, schedule.EffectiveFrom.Format(time.RFC3339))
+	return schedule, nil
+}
+
+// ErrInvalidFeeSchedule wraps every fee schedule validation failure
+var ErrInvalidFeeSchedule = errors.New("invalid fee schedule")
+
+// ValidateFeeSchedule checks slab ordering, caps and rate ranges
+func ValidateFeeSchedule(schedule *models.FeeSchedule) error {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidFeeSchedule, fmt.Sprintf(format, args...))
+	}
+
+	if len(schedule.BrokerageSlabs) == 0 {
+		return invalid("at least one brokerage slab is required")
+	}
+
+	lastUpTo := 0.0
+	for i, slab := range schedule.BrokerageSlabs {
+		isLast := i == len(schedule.BrokerageSlabs)-1
+
+		switch slab.Type {
+		case BrokerageFlat:
+			if slab.Flat < 0 {
+				return invalid("slab %d: flat brokerage cannot be negative", i)
+			}
+		case BrokeragePercent:
+			if slab.RateBP < 0 || slab.RateBP > 10000 {
+				return invalid("slab %d: rate_bp must be between 0 and 10000", i)
+			}
+		default:
+			return invalid("slab %d: type must be %q or %q", i, BrokerageFlat, BrokeragePercent)
+		}
+
+		if slab.Min < 0 || slab.Max < 0 {
+			return invalid("slab %d: min and max cannot be negative", i)
+		}
+		if slab.Max > 0 && slab.Min > slab.Max {
+			return invalid("slab %d: min exceeds max", i)
+		}
+
+		if isLast {
+			if slab.UpTo != nil {
+				return invalid("last slab must be unbounded (omit up_to)")
+			}
+			continue
+		}
+		if slab.UpTo == nil {
+			return invalid("slab %d: only the last slab may omit up_to", i)
+		}
+		if *slab.UpTo <= lastUpTo {
+			return invalid("slab %d: up_to must be greater than the previous slab's", i)
+		}
+		lastUpTo = *slab.UpTo
+	}
+
+	for name, bp := range map[string]float64{
+		"stt_bp":        schedule.STTBP,
+		"exchange_bp":   schedule.ExchangeBP,
+		"sebi_bp":       schedule.SEBIBP,
+		"stamp_duty_bp": schedule.StampDutyBP,
+	} {
+		if bp < 0 || bp > 10000 {
+			return invalid("%s must be between 0 and 10000", name)
+		}
+	}
+
+	if schedule.GSTPct < 0 || schedule.GSTPct > 100 {
+		return invalid("gst_pct must be between 0 and 100")
+	}
+
+	return nil
+}
+
+type Fees struct {
+	Brokerage float64
+	STT       float64
+	GST       float64
+	Exchange  float64
+	SEBI      float64
+	StampDuty float64
+	Total     float64
+}
+
+// calculateFees prices a trade of totalValue INR under the given schedule.
+// GST is levied on brokerage, exchange and SEBI charges; STT and stamp
+// duty are statutory levies and are not subject to GST. Stamp duty is only
+// charged on the buy side.
+func calculateFees(schedule *models.FeeSchedule, totalValue float64, side string) Fees {
+	brokerage := calculateBrokerage(schedule.BrokerageSlabs, totalValue)
+	stt := totalValue * schedule.STTBP / 10000
+	exchange := totalValue * schedule.ExchangeBP / 10000
+	sebi := totalValue * schedule.SEBIBP / 10000
+	stampDuty := 0.0
+	if side == TradeBuy {
+		stampDuty = totalValue * schedule.StampDutyBP / 10000
+	}
+	gst := (brokerage + exchange + sebi) * schedule.GSTPct / 100
+
+	total := brokerage + stt + gst + exchange + sebi + stampDuty
+
+	return Fees{
+		Brokerage: roundToDecimal(brokerage, 4),
+		STT:       roundToDecimal(stt, 4),
+		GST:       roundToDecimal(gst, 4),
+		Exchange:  roundToDecimal(exchange, 4),
+		SEBI:      roundToDecimal(sebi, 4),
+		StampDuty: roundToDecimal(stampDuty, 4),
+		Total:     roundToDecimal(total, 4),
+	}
+}
+
+// calculateBrokerage applies the first slab whose bound covers totalValue
+func calculateBrokerage(slabs []models.BrokerageSlab, totalValue float64) float64 {
+	for _, slab := range slabs {
+		if slab.UpTo != nil && totalValue > *slab.UpTo {
+			continue
+		}
+
+		brokerage := slab.Flat
+		if slab.Type == BrokeragePercent {
+			brokerage = totalValue * slab.RateBP / 10000
+		}
+
+		if brokerage < slab.Min {
+			brokerage = slab.Min
+		}
+		if slab.Max > 0 && brokerage > slab.Max {
+			brokerage = slab.Max
+		}
+		return brokerage
+	}
+	return 0
+}
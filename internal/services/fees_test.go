@@ -0,0 +1,134 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stocky/assignment/internal/models"
+)
+
+func upTo(v float64) *float64 { return &v }
+
+// testSlabs charge a flat 5 up to 10,000, 0.05% capped to 5-20 up to 100,000,
+// then 0.03% with a minimum of 40
+var testSlabs = []models.BrokerageSlab{
+	{UpTo: upTo(10000), Type: BrokerageFlat, Flat: 5},
+	{UpTo: upTo(100000), Type: BrokeragePercent, RateBP: 5, Min: 5, Max: 20},
+	{Type: BrokeragePercent, RateBP: 3, Min: 40},
+}
+
+func TestCalculateBrokerage(t *testing.T) {
+	cases := []struct {
+		name  string
+		value float64
+		want  float64
+	}{
+		{"flat slab", 2000, 5},
+		{"flat slab upper bound is inclusive", 10000, 5},
+		{"just past the flat slab", 10000.01, 5.000005},
+		{"percent slab", 10500, 5.25},
+		{"percent slab between caps", 30000, 15},
+		{"percent slab at max cap", 40000, 20},
+		{"percent slab above max cap", 90000, 20},
+		{"percent slab upper bound is inclusive", 100000, 20},
+		{"unbounded slab raised to its min", 100000.01, 40},
+		{"unbounded slab above its min", 200000, 60},
+		{"unbounded slab has no max", 1e7, 3000},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := calculateBrokerage(testSlabs, tc.value); roundToDecimal(got, 6) != tc.want {
+				t.Errorf("calculateBrokerage(%v) = %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCalculateBrokerageMinCap(t *testing.T) {
+	slabs := []models.BrokerageSlab{{Type: BrokeragePercent, RateBP: 5, Min: 20}}
+	if got := calculateBrokerage(slabs, 1000); got != 20 {
+		t.Errorf("0.05%% of 1000 with a 20 minimum = %v, want 20", got)
+	}
+}
+
+func TestCalculateFees(t *testing.T) {
+	schedule := &models.FeeSchedule{
+		BrokerageSlabs: []models.BrokerageSlab{{Type: BrokeragePercent, RateBP: 5, Max: 20}},
+		STTBP:          10,
+		ExchangeBP:     3,
+		SEBIBP:         1,
+		StampDutyBP:    1.5,
+		GSTPct:         18,
+	}
+
+	cases := []struct {
+		name  string
+		value float64
+		side  string
+		want  Fees
+	}{
+		{
+			// GST is 18% of brokerage 5 + exchange 3 + SEBI 1, not of STT or stamp duty
+			name: "buy pays stamp duty", value: 10000, side: TradeBuy,
+			want: Fees{Brokerage: 5, STT: 10, Exchange: 3, SEBI: 1, StampDuty: 1.5, GST: 1.62, Total: 22.12},
+		},
+		{
+			name: "sell pays no stamp duty", value: 10000, side: TradeSell,
+			want: Fees{Brokerage: 5, STT: 10, Exchange: 3, SEBI: 1, StampDuty: 0, GST: 1.62, Total: 20.62},
+		},
+		{
+			// Brokerage is capped at 20, so GST is 18% of 20 + 60 + 20
+			name: "GST base uses the capped brokerage", value: 200000, side: TradeSell,
+			want: Fees{Brokerage: 20, STT: 200, Exchange: 60, SEBI: 20, StampDuty: 0, GST: 18, Total: 318},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := calculateFees(schedule, tc.value, tc.side); got != tc.want {
+				t.Errorf("calculateFees(%v, %s) = %+v, want %+v", tc.value, tc.side, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateFeeSchedule(t *testing.T) {
+	valid := func() *models.FeeSchedule {
+		return &models.FeeSchedule{
+			BrokerageSlabs: append([]models.BrokerageSlab(nil), testSlabs...),
+			STTBP:          10,
+			GSTPct:         18,
+		}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*models.FeeSchedule)
+		ok     bool
+	}{
+		{"valid", func(*models.FeeSchedule) {}, true},
+		{"no slabs", func(s *models.FeeSchedule) { s.BrokerageSlabs = nil }, false},
+		{"unknown slab type", func(s *models.FeeSchedule) { s.BrokerageSlabs[0].Type = "tiered" }, false},
+		{"negative flat", func(s *models.FeeSchedule) { s.BrokerageSlabs[0].Flat = -1 }, false},
+		{"rate above 100%", func(s *models.FeeSchedule) { s.BrokerageSlabs[1].RateBP = 10001 }, false},
+		{"min above max", func(s *models.FeeSchedule) { s.BrokerageSlabs[1].Min = 30 }, false},
+		{"negative max", func(s *models.FeeSchedule) { s.BrokerageSlabs[1].Max = -1 }, false},
+		{"bounds not increasing", func(s *models.FeeSchedule) { s.BrokerageSlabs[1].UpTo = upTo(10000) }, false},
+		{"bounded last slab", func(s *models.FeeSchedule) { s.BrokerageSlabs[2].UpTo = upTo(1e9) }, false},
+		{"unbounded middle slab", func(s *models.FeeSchedule) { s.BrokerageSlabs[1].UpTo = nil }, false},
+		{"negative stamp duty", func(s *models.FeeSchedule) { s.StampDutyBP = -1 }, false},
+		{"GST above 100%", func(s *models.FeeSchedule) { s.GSTPct = 101 }, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := valid()
+			tc.modify(schedule)
+			err := ValidateFeeSchedule(schedule)
+			if tc.ok && err != nil {
+				t.Errorf("ValidateFeeSchedule: %v", err)
+			}
+			if !tc.ok && !errors.Is(err, ErrInvalidFeeSchedule) {
+				t.Errorf("ValidateFeeSchedule = %v, want ErrInvalidFeeSchedule", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// HealthService reports whether the process is alive and ready for traffic
+type HealthService interface {
+	Readiness(ctx context.Context) *ReadinessReport
+}
+
+type HealthConfig struct {
+	ExpectedMigrationVersion int
+	MaxDBPingLatency         time.Duration
+	MaxPriceAge              time.Duration
+	CheckTimeout             time.Duration
+}
+
+type ReadinessReport struct {
+	Ready     bool                       `json:"ready"`
+	Timestamp string                     `json:"timestamp"`
+	Checks    map[string]ComponentStatus `json:"checks"`
+}
+
+type ComponentStatus struct {
+	Status  string                 `json:"status"`
+	Error   string                 `json:"error,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+type healthService struct {
+	healthRepo repository.HealthRepository
+	config     HealthConfig
+	log        *logrus.Logger
+}
+
+func NewHealthService(healthRepo repository.HealthRepository, config HealthConfig, log *logrus.Logger) HealthService {
+	return &healthService{
+		healthRepo: healthRepo,
+		config:     config,
+		log:        log,
+	}
+}
+
+// Readiness checks every dependency; the pod is ready only if all are up
+func (s *healthService) Readiness(ctx context.Context) *ReadinessReport {
+	if s.config.CheckTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.CheckTimeout)
+		defer cancel()
+	}
+
+	checks := map[string]ComponentStatus{
+		"database": s.checkDatabase(ctx),
+	}
+
+	if replica, ok := s.checkReplica(ctx); ok {
+		checks["database_replica"] = replica
+	}
+
+	// Skip queries that can't succeed if the database is unreachable
+	if checks["database"].Status == StatusUp {
+		checks["migrations"] = s.checkMigrations(ctx)
+		checks["stock_prices"] = s.checkPriceFreshness(ctx)
+	} else {
+		skipped := ComponentStatus{Status: StatusDown, Error: "database unavailable"}
+		checks["migrations"] = skipped
+		checks["stock_prices"] = skipped
+	}
+
+	ready := true
+	for name, check := range checks {
+		if check.Status != StatusUp {
+			ready = false
+			s.log.Warnf("Readiness check %s failed: %s", name, check.Error)
+		}
+	}
+
+	return &ReadinessReport{
+		Ready:     ready,
+		Timestamp: time.Now().Format(time.RFC3339),
+		Checks:    checks,
+	}
+}
+
+func (s *healthService) checkDatabase(ctx context.Context) ComponentStatus {
+	start := time.Now()
+	err := s.healthRepo.Ping(ctx)
+	latency := time.Since(start)
+
+	status := ComponentStatus{
+		Status: StatusUp,
+		Details: map[string]interface{}{
+			"latency_ms":     latency.Milliseconds(),
+			"max_latency_ms": s.config.MaxDBPingLatency.Milliseconds(),
+		},
+	}
+
+	switch {
+	case err != nil:
+		status.Status = StatusDown
+		status.Error = err.Error()
+	case s.config.MaxDBPingLatency > 0 && latency > s.config.MaxDBPingLatency:
+		status.Status = StatusDown
+		status.Error = fmt.Sprintf("ping latency %s exceeds %s", latency, s.config.MaxDBPingLatency)
+	}
+
+	return status
+}
+
+// checkReplica pings the read replica; ok is false when none is configured
+func (s *healthService) checkReplica(ctx context.Context) (ComponentStatus, bool) {
+	start := time.Now()
+	err := s.healthRepo.PingReplica(ctx)
+	if errors.Is(err, repository.ErrNoReplica) {
+		return ComponentStatus{}, false
+	}
+	latency := time.Since(start)
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, Error: err.Error()}, true
+	}
+
+	status := ComponentStatus{
+		Status: StatusUp,
+		Details: map[string]interface{}{
+			"latency_ms": latency.Milliseconds(),
+		},
+	}
+	// Lag is informational: reads tolerate it, so it does not fail readiness
+	if lag, err := s.healthRepo.GetReplicationLag(ctx); err == nil {
+		status.Details["replication_lag_ms"] = lag.Milliseconds()
+	} else {
+		s.log.WithError(err).Warn("Failed to read replication lag")
+	}
+	return status, true
+}
+
+func (s *healthService) checkMigrations(ctx context.Context) ComponentStatus {
+	version, err := s.healthRepo.GetMigrationVersion(ctx)
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, Error: err.Error()}
+	}
+
+	status := ComponentStatus{
+		Status: StatusUp,
+		Details: map[string]interface{}{
+			"current_version":  version,
+			"expected_version": s.config.ExpectedMigrationVersion,
+		},
+	}
+
+	if version < s.config.ExpectedMigrationVersion {
+		status.Status = StatusDown
+		status.Error = fmt.Sprintf("schema at version %d, expected %d", version, s.config.ExpectedMigrationVersion)
+	}
+
+	return status
+}
+
+func (s *healthService) checkPriceFreshness(ctx context.Context) ComponentStatus {
+	latest, err := s.healthRepo.GetLatestPriceTimestamp(ctx)
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, Error: err.Error()}
+	}
+	if latest == nil {
+		return ComponentStatus{Status: StatusDown, Error: "no stock prices recorded"}
+	}
+
+	age := time.Since(*latest)
+	status := ComponentStatus{
+		Status: StatusUp,
+		Details: map[string]interface{}{
+			"latest_at":       latest.Format(time.RFC3339),
+			"age_seconds":     int64(age.Seconds()),
+			"max_age_seconds": int64(s.config.MaxPriceAge.Seconds()),
+		},
+	}
+
+	if s.config.MaxPriceAge > 0 && age > s.config.MaxPriceAge {
+		status.Status = StatusDown
+		status.Error = fmt.Sprintf("latest price is %s old, threshold is %s", age.Round(time.Second), s.config.MaxPriceAge)
+	}
+
+	return status
+}
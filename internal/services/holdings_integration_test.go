@@ -0,0 +1,242 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/services"
+	"github.com/stocky/assignment/internal/testdb"
+)
+
+// TestConcurrentRewardsForOneHolding fires parallel rewards at one user and stock,
+// moving the price between them, and checks that no share or cost was lost
+func TestConcurrentRewardsForOneHolding(t *testing.T) {
+	db := testdb.Open(t)
+	ctx := context.Background()
+
+	const (
+		rewards = 300
+		symbol  = "TCS"
+	)
+	userID := fmt.Sprintf("concurrency-%d", time.Now().UnixNano())
+
+	stockRepo := repository.NewStockRepository(db)
+	rewardService := newDBRewardService(db, stockRepo)
+
+	setPrice := func(price float64) {
+		err := stockRepo.CreateStockPrice(ctx, &models.StockPrice{
+			StockSymbol: symbol,
+			Exchange:    services.ExchangeNSE,
+			Price:       price,
+			Timestamp:   time.Now(),
+			Source:      "test",
+		})
+		if err != nil {
+			t.Errorf("failed to set price: %v", err)
+		}
+	}
+	setPrice(3500)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		shares float64
+		cost   float64
+	)
+	for i := 0; i < rewards; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%25 == 0 {
+				setPrice(3500 + float64(i))
+			}
+			event, err := rewardService.CreateReward(ctx, &services.RewardRequest{
+				IdempotencyKey: fmt.Sprintf("%s-%d", userID, i),
+				UserID:         userID,
+				StockSymbol:    symbol,
+				SharesQuantity: float64(i%7+1) * 0.5,
+				Reason:         "concurrency_test",
+			})
+			if err != nil {
+				t.Errorf("reward %d: %v", i, err)
+				return
+			}
+			mu.Lock()
+			shares += event.SharesQuantity
+			cost += event.SharesQuantity * event.PricePerShare
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	var totalShares, averagePrice float64
+	err := db.QueryRowContext(ctx,
+		`SELECT total_shares, average_price FROM user_holdings WHERE user_id = $1 AND stock_symbol = $2`,
+		userID, symbol,
+	).Scan(&totalShares, &averagePrice)
+	if err != nil {
+		t.Fatalf("failed to read holding: %v", err)
+	}
+
+	if math.Abs(totalShares-shares) > 1e-6 {
+		t.Errorf("total_shares = %.6f, want %.6f", totalShares, shares)
+	}
+	// average_price is stored to 4 decimals after every reward, so allow for rounding drift
+	if want := cost / shares; math.Abs(averagePrice-want) > 0.01 {
+		t.Errorf("average_price = %.4f, want %.4f", averagePrice, want)
+	}
+}
+
+// TestConcurrentSellsForOneHolding fires more sells at one holding than it can fill
+// and checks that no share was sold twice: the successful sells add up to at most
+// what was held, every other sell fails with ErrInsufficientShares, and
+// total_shares ends at what is left, never below zero
+func TestConcurrentSellsForOneHolding(t *testing.T) {
+	db := testdb.Open(t)
+	ctx := context.Background()
+
+	const (
+		held   = 20.0
+		sells  = 100
+		symbol = "INFY"
+	)
+	userID := fmt.Sprintf("concurrent-sell-%d", time.Now().UnixNano())
+
+	stockRepo := repository.NewStockRepository(db)
+	err := stockRepo.CreateStockPrice(ctx, &models.StockPrice{
+		StockSymbol: symbol,
+		Exchange:    services.ExchangeNSE,
+		Price:       1500,
+		Timestamp:   time.Now(),
+		Source:      "test",
+	})
+	if err != nil {
+		t.Fatalf("failed to set price: %v", err)
+	}
+
+	rewardService := newDBRewardService(db, stockRepo)
+	for i := 0; i < 4; i++ {
+		_, err := rewardService.CreateReward(ctx, &services.RewardRequest{
+			IdempotencyKey: fmt.Sprintf("%s-reward-%d", userID, i),
+			UserID:         userID,
+			StockSymbol:    symbol,
+			SharesQuantity: held / 4,
+			Reason:         "concurrency_test",
+		})
+		if err != nil {
+			t.Fatalf("reward %d: %v", i, err)
+		}
+	}
+
+	disposalService := newDBDisposalService(db, stockRepo)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sold float64
+	)
+	for i := 0; i < sells; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			disposal, err := disposalService.Sell(ctx, &services.SellRequest{
+				IdempotencyKey: fmt.Sprintf("%s-sell-%d", userID, i),
+				UserID:         userID,
+				StockSymbol:    symbol,
+				Quantity:       float64(i%3+1) * 0.5,
+			})
+			if errors.Is(err, services.ErrInsufficientShares) {
+				return
+			}
+			if err != nil {
+				t.Errorf("sell %d: %v", i, err)
+				return
+			}
+			mu.Lock()
+			sold += disposal.Quantity
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	if sold > held+1e-6 {
+		t.Errorf("sold %.6f shares of the %.6f held", sold, held)
+	}
+	var totalShares float64
+	err = db.QueryRowContext(ctx,
+		`SELECT total_shares FROM user_holdings WHERE user_id = $1 AND stock_symbol = $2`,
+		userID, symbol,
+	).Scan(&totalShares)
+	if err != nil {
+		t.Fatalf("failed to read holding: %v", err)
+	}
+	if totalShares < 0 {
+		t.Errorf("total_shares = %.6f, want it never negative", totalShares)
+	}
+	if math.Abs(totalShares-(held-sold)) > 1e-6 {
+		t.Errorf("total_shares = %.6f, want %.6f held - %.6f sold", totalShares, held, sold)
+	}
+}
+
+// newDBDisposalService wires a DisposalService to db with no settlement period
+func newDBDisposalService(db *sql.DB, stockRepo repository.StockRepository) services.DisposalService {
+	log := testdb.Logger()
+	txManager := repository.NewTxManager(db)
+	auditRepo := repository.NewAuditRepository(db)
+	priceService := services.NewStockPriceService(stockRepo, services.NewPriceHub(), log)
+	feeService := services.NewFeeScheduleService(txManager, repository.NewFeeScheduleRepository(db), auditRepo,
+		services.FeesConfig{BrokerageFeeBP: 5, STTFeeBP: 10, GSTFeePercent: 18, ExchangeFeeBP: 3, SEBIFeeBP: 1}, log)
+	return services.NewDisposalService(
+		txManager,
+		repository.NewRewardRepository(db),
+		repository.NewTaxLotRepository(db),
+		repository.NewDisposalRepository(db),
+		repository.NewVestingRepository(db),
+		repository.NewLedgerRepository(db),
+		priceService,
+		feeService,
+		auditRepo,
+		services.DisposalConfig{},
+		log,
+	)
+}
+
+// newDBRewardService wires a RewardService to db with budgets and risk checks off
+func newDBRewardService(db *sql.DB, stockRepo repository.StockRepository) services.RewardService {
+	log := testdb.Logger()
+	txManager := repository.NewTxManager(db)
+	auditRepo := repository.NewAuditRepository(db)
+	priceService := services.NewStockPriceService(stockRepo, services.NewPriceHub(), log)
+	feeService := services.NewFeeScheduleService(txManager, repository.NewFeeScheduleRepository(db), auditRepo,
+		services.FeesConfig{BrokerageFeeBP: 5, STTFeeBP: 10, GSTFeePercent: 18, ExchangeFeeBP: 3, SEBIFeeBP: 1}, log)
+	return services.NewRewardService(
+		txManager,
+		repository.NewRewardRepository(db),
+		stockRepo,
+		repository.NewLedgerRepository(db),
+		repository.NewTaxLotRepository(db),
+		repository.NewVestingRepository(db),
+		repository.NewWebhookRepository(db),
+		repository.NewBudgetRepository(db),
+		repository.NewRiskRepository(db),
+		auditRepo,
+		priceService,
+		feeService,
+		services.RewardBudgetConfig{},
+		nil,
+		log,
+	)
+}
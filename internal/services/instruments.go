@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// Exchanges a stock can be listed on
+const (
+	ExchangeNSE = "NSE"
+	ExchangeBSE = "BSE"
+)
+
+var (
+	ErrInvalidInstrument = errors.New("invalid instrument reference")
+	ErrUnknownInstrument = errors.New("unknown instrument")
+)
+
+// Instrument is the stock a reward refers to and the listing it is priced on
+type Instrument struct {
+	Stock    *models.Stock
+	Exchange string
+}
+
+// ValidExchange reports whether exchange is one Stocky lists stocks on
+func ValidExchange(exchange string) bool {
+	return exchange == ExchangeNSE || exchange == ExchangeBSE
+}
+
+// ValidISIN checks an ISIN's format and its check digit: two letters of country code,
+// nine alphanumerics and a Luhn check digit over the letters expanded to numbers
+// (A=10 ... Z=35)
+func ValidISIN(isin string) bool {
+	if len(isin) != 12 {
+		return false
+	}
+
+	var digits []int
+	for i, r := range isin {
+		switch {
+		case r >= '0' && r <= '9' && i >= 2:
+			digits = append(digits, int(r-'0'))
+		case r >= 'A' && r <= 'Z' && i < 11:
+			n := int(r-'A') + 10
+			digits = append(digits, n/10, n%10)
+		default:
+			return false
+		}
+	}
+
+	// Luhn: double every second digit from the right, excluding the check digit
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// ParseListingRef splits an exchange:symbol reference such as "BSE:RELIANCE". ok is
+// false for a plain symbol.
+func ParseListingRef(ref string) (exchange, symbol string, ok bool) {
+	exchange, symbol, ok = strings.Cut(ref, ":")
+	if !ok {
+		return "", ref, false
+	}
+	return strings.ToUpper(strings.TrimSpace(exchange)), strings.ToUpper(strings.TrimSpace(symbol)), true
+}
+
+// ResolveInstrument finds the stock a reward refers to. ref is either Stocky's own
+// symbol, priced on the stock's primary listing, or exchange:symbol, priced on that
+// listing. isin names the stock on its primary listing; when both are given they
+// must name the same stock, and ref picks the listing.
+func ResolveInstrument(ctx context.Context, stockRepo repository.StockRepository, ref, isin string) (*Instrument, error) {
+	var byISIN *models.Stock
+	if isin != "" {
+		isin = strings.ToUpper(isin)
+		if !ValidISIN(isin) {
+			return nil, fmt.Errorf("%w: %s is not a valid ISIN", ErrInvalidInstrument, isin)
+		}
+		stock, err := stockRepo.GetStockByISIN(ctx, isin)
+		if err != nil {
+			return nil, err
+		}
+		if stock == nil {
+			return nil, fmt.Errorf("%w: no stock has ISIN %s", ErrUnknownInstrument, isin)
+		}
+		if ref == "" {
+			return &Instrument{Stock: stock, Exchange: stock.Exchange}, nil
+		}
+		byISIN = stock
+	}
+
+	instrument, err := resolveSymbolRef(ctx, stockRepo, ref)
+	if err != nil {
+		return nil, err
+	}
+	if byISIN != nil && instrument.Stock.Symbol != byISIN.Symbol {
+		return nil, fmt.Errorf("%w: %s is not ISIN %s", ErrInvalidInstrument, ref, isin)
+	}
+	return instrument, nil
+}
+
+func resolveSymbolRef(ctx context.Context, stockRepo repository.StockRepository, ref string) (*Instrument, error) {
+	exchange, symbol, ok := ParseListingRef(ref)
+	if !ok {
+		stock, err := stockRepo.GetStockBySymbol(ctx, symbol)
+		if errors.Is(err, repository.ErrStockNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownInstrument, symbol)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &Instrument{Stock: stock, Exchange: stock.Exchange}, nil
+	}
+
+	if !ValidExchange(exchange) || symbol == "" {
+		return nil, fmt.Errorf("%w: %s is not exchange:symbol on NSE or BSE", ErrInvalidInstrument, ref)
+	}
+	listing, err := stockRepo.GetListing(ctx, exchange, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if listing == nil || !listing.IsActive {
+		return nil, fmt.Errorf("%w: %s has no active listing %s", ErrUnknownInstrument, exchange, symbol)
+	}
+	stock, err := stockRepo.GetStockByISIN(ctx, listing.ISIN)
+	if err != nil {
+		return nil, err
+	}
+	if stock == nil {
+		return nil, fmt.Errorf("%w: no stock has ISIN %s", ErrUnknownInstrument, listing.ISIN)
+	}
+	return &Instrument{Stock: stock, Exchange: listing.Exchange}, nil
+}
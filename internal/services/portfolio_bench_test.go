@@ -0,0 +1,131 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/testdb"
+)
+
+// benchPriceRows is how many stock_prices rows BenchmarkGetUserPortfolio seeds;
+// override it with STOCKY_BENCH_PRICE_ROWS
+const benchPriceRows = 5_000_000
+
+const benchUserID = "bench-portfolio-user"
+
+// BenchmarkGetUserPortfolio measures the portfolio read, which takes current prices
+// from latest_stock_prices, with millions of ticks in stock_prices. Its time per op
+// must not grow with the tick history. A second sub-benchmark runs the old query per
+// holding against the same rows for comparison. Seeding runs once per database.
+func BenchmarkGetUserPortfolio(b *testing.B) {
+	db := testdb.Open(b)
+	ctx := context.Background()
+
+	rows := benchPriceRows
+	if v := os.Getenv("STOCKY_BENCH_PRICE_ROWS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			b.Fatalf("invalid STOCKY_BENCH_PRICE_ROWS: %v", err)
+		}
+		rows = n
+	}
+
+	var seeded int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM stock_prices WHERE source = 'bench'`).Scan(&seeded); err != nil {
+		b.Fatalf("failed to count seeded prices: %v", err)
+	}
+	if seeded < rows {
+		b.Logf("seeding %d stock_prices rows", rows-seeded)
+		// One tick per second per listing, going back from a day ago, so the rows
+		// never replace a real latest price
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO stock_prices (stock_symbol, exchange, price, timestamp, source)
+			SELECT l.stock_symbol, l.exchange, 100 + random() * 1000,
+				   (NOW() AT TIME ZONE 'UTC') - INTERVAL '1 day' - (g.n + $2) * INTERVAL '1 second', 'bench'
+			FROM (SELECT s.symbol AS stock_symbol, sl.exchange
+				  FROM stock_listings sl JOIN stocks s ON s.isin = sl.isin) l
+			CROSS JOIN generate_series(1, CEIL($1::numeric / (SELECT COUNT(*) FROM stock_listings))::int) g(n)
+			ON CONFLICT DO NOTHING
+		`, rows-seeded, seeded)
+		if err != nil {
+			b.Fatalf("failed to seed stock_prices: %v", err)
+		}
+		if _, err := db.ExecContext(ctx, `ANALYZE stock_prices`); err != nil {
+			b.Fatalf("failed to analyze stock_prices: %v", err)
+		}
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO user_holdings (user_id, stock_symbol, total_shares, average_price, last_updated)
+		SELECT $1, symbol, 10, 1000, NOW() FROM stocks
+		ON CONFLICT (user_id, stock_symbol) DO NOTHING
+	`, benchUserID)
+	if err != nil {
+		b.Fatalf("failed to seed holdings: %v", err)
+	}
+
+	// The uncached repository, so every op reads latest_stock_prices
+	rewardService := newDBRewardService(db, repository.NewStockRepository(db))
+	b.Run("latest_stock_prices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			portfolio, err := rewardService.GetUserPortfolio(ctx, benchUserID)
+			if err != nil {
+				b.Fatalf("GetUserPortfolio: %v", err)
+			}
+			if len(portfolio) == 0 {
+				b.Fatalf("no holdings for %s", benchUserID)
+			}
+		}
+		b.ReportMetric(float64(rows), "price_rows")
+	})
+
+	// The query shape latest_stock_prices replaced: the holdings, then one
+	// newest-tick lookup in stock_prices per holding
+	b.Run("stock_prices_per_holding", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if n := portfolioPerHolding(ctx, b, db); n == 0 {
+				b.Fatalf("no holdings for %s", benchUserID)
+			}
+		}
+		b.ReportMetric(float64(rows), "price_rows")
+	})
+}
+
+// portfolioPerHolding reads the bench user's holdings and the latest price of each
+// with a query per holding, and returns how many holdings it priced
+func portfolioPerHolding(ctx context.Context, b *testing.B, db *sql.DB) int {
+	rows, err := db.QueryContext(ctx, `SELECT stock_symbol FROM user_holdings WHERE user_id = $1`, benchUserID)
+	if err != nil {
+		b.Fatalf("failed to list holdings: %v", err)
+	}
+	var symbols []string
+	for rows.Next() {
+		var symbol string
+		if err := rows.Scan(&symbol); err != nil {
+			b.Fatalf("failed to scan holding: %v", err)
+		}
+		symbols = append(symbols, symbol)
+	}
+	if err := rows.Close(); err != nil {
+		b.Fatalf("failed to list holdings: %v", err)
+	}
+
+	for _, symbol := range symbols {
+		var price float64
+		err := db.QueryRowContext(ctx, `
+			SELECT price FROM stock_prices
+			WHERE stock_symbol = $1
+			ORDER BY timestamp DESC
+			LIMIT 1
+		`, symbol).Scan(&price)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			b.Fatalf("failed to read latest price of %s: %v", symbol, err)
+		}
+	}
+	return len(symbols)
+}
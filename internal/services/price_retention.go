@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+const (
+	// partitionsAhead is how many future months of stock_prices partitions are kept ready
+	partitionsAhead = 3
+	// partitionNameLayout matches the names created by ensure_stock_price_partitions
+	partitionNameLayout = "stock_prices_y2006m01"
+	// priceRetentionTimeout bounds a single retention run
+	priceRetentionTimeout = 10 * time.Minute
+)
+
+// PriceRetentionConfig controls how long raw price ticks are kept
+type PriceRetentionConfig struct {
+	RetentionDays int           // Ticks older than this are rolled up into daily OHLC rows and removed
+	Interval      time.Duration // How often the job runs
+}
+
+type PriceRetentionResult struct {
+	Cutoff            time.Time `json:"cutoff"`
+	PartitionsCreated int       `json:"partitions_created"`
+	DaysDownsampled   int64     `json:"days_downsampled"`
+	PartitionsDropped []string  `json:"partitions_dropped"`
+	TicksDeleted      int64     `json:"ticks_deleted"`
+}
+
+// PriceRetentionService keeps stock_prices partitioned and bounded in size
+type PriceRetentionService interface {
+	RunOnce(ctx context.Context) (*PriceRetentionResult, error)
+	Start()
+	Stop()
+}
+
+type priceRetentionService struct {
+	txManager     repository.TxManager
+	retentionRepo repository.PriceRetentionRepository
+	config        PriceRetentionConfig
+	log           *logrus.Logger
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewPriceRetentionService(
+	txManager repository.TxManager,
+	retentionRepo repository.PriceRetentionRepository,
+	config PriceRetentionConfig,
+	log *logrus.Logger,
+) PriceRetentionService {
+	return &priceRetentionService{
+		txManager:     txManager,
+		retentionRepo: retentionRepo,
+		config:        config,
+		log:           log,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// RunOnce creates upcoming partitions, then rolls up and removes expired ticks.
+// The rollup and the removal share a transaction, so a tick is never lost without
+// being counted in stock_prices_daily.
+func (s *priceRetentionService) RunOnce(ctx context.Context) (*PriceRetentionResult, error) {
+	now := time.Now()
+	// Whole days only, so a day is never split between raw ticks and its rollup
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	result := &PriceRetentionResult{
+		Cutoff: today.AddDate(0, 0, -s.config.RetentionDays),
+	}
+
+	created, err := s.retentionRepo.EnsurePartitions(ctx, today, today.AddDate(0, partitionsAhead, 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create partitions: %w", err)
+	}
+	result.PartitionsCreated = created
+
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		repo := s.retentionRepo.WithTx(tx)
+
+		days, err := repo.DownsampleBefore(ctx, result.Cutoff)
+		if err != nil {
+			return fmt.Errorf("failed to downsample ticks: %w", err)
+		}
+		result.DaysDownsampled = days
+
+		partitions, err := repo.ListPartitions(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list partitions: %w", err)
+		}
+		for _, name := range partitions {
+			month, err := time.Parse(partitionNameLayout, name)
+			if err != nil {
+				continue // The default partition, or not one of ours
+			}
+			if month.AddDate(0, 1, 0).After(result.Cutoff) {
+				continue
+			}
+			if err := repo.DropPartition(ctx, name); err != nil {
+				return fmt.Errorf("failed to drop partition %s: %w", name, err)
+			}
+			result.PartitionsDropped = append(result.PartitionsDropped, name)
+		}
+
+		deleted, err := repo.DeleteTicksBefore(ctx, result.Cutoff)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired ticks: %w", err)
+		}
+		result.TicksDeleted = deleted
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Start runs the retention job in the background, once immediately and then every Interval
+func (s *priceRetentionService) Start() {
+	ticker := time.NewTicker(s.config.Interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer ticker.Stop()
+
+		for {
+			s.run()
+
+			select {
+			case <-ticker.C:
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+
+	s.log.Infof("Price retention job started (retention: %d days, interval: %s)", s.config.RetentionDays, s.config.Interval)
+}
+
+// Stop signals the retention job to exit and waits for an in-flight run to finish
+func (s *priceRetentionService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+
+	s.log.Info("Price retention job stopped")
+}
+
+func (s *priceRetentionService) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), priceRetentionTimeout)
+	defer cancel()
+
+	result, err := s.RunOnce(ctx)
+	if err != nil {
+		s.log.Errorf("Price retention run failed: %v", err)
+		return
+	}
+
+	s.log.Infof("Price retention: cutoff=%s, partitions created=%d, days downsampled=%d, partitions dropped=%v, ticks deleted=%d",
+		result.Cutoff.Format("2006-01-02"), result.PartitionsCreated, result.DaysDownsampled,
+		result.PartitionsDropped, result.TicksDeleted)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stocky/assignment/internal/metrics"
+)
+
+// PriceTick is published by the price updater for every stored price
+type PriceTick struct {
+	StockSymbol string    `json:"stock_symbol"`
+	Exchange    string    `json:"exchange"`
+	Price       float64   `json:"price"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// PriceHub fans price ticks out to in-process subscribers
+type PriceHub interface {
+	// Publish never blocks: a subscriber whose buffer is full loses its oldest tick
+	Publish(tick PriceTick)
+	// Subscribe returns a tick channel and a function that unsubscribes and closes it
+	Subscribe(buffer int) (<-chan PriceTick, func())
+	// Close closes every subscriber channel; later subscriptions receive a closed channel
+	Close()
+}
+
+type priceHub struct {
+	mu          sync.Mutex
+	subscribers map[chan PriceTick]struct{}
+	closed      bool
+}
+
+func NewPriceHub() PriceHub {
+	return &priceHub{
+		subscribers: make(map[chan PriceTick]struct{}),
+	}
+}
+
+func (h *priceHub) Publish(tick PriceTick) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- tick:
+			continue
+		default:
+		}
+
+		// Slow consumer: drop its oldest tick to make room. Only the hub sends
+		// on ch and we hold the lock, so the retry below cannot block.
+		select {
+		case <-ch:
+			metrics.StreamDroppedTicks.Inc()
+		default:
+		}
+		select {
+		case ch <- tick:
+		default:
+			metrics.StreamDroppedTicks.Inc()
+		}
+	}
+}
+
+func (h *priceHub) Subscribe(buffer int) (<-chan PriceTick, func()) {
+	ch := make(chan PriceTick, buffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	h.subscribers[ch] = struct{}{}
+	metrics.StreamSubscribers.Inc()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			if _, ok := h.subscribers[ch]; ok {
+				delete(h.subscribers, ch)
+				close(ch)
+				metrics.StreamSubscribers.Dec()
+			}
+		})
+	}
+	return ch, unsubscribe
+}
+
+func (h *priceHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for ch := range h.subscribers {
+		delete(h.subscribers, ch)
+		close(ch)
+		metrics.StreamSubscribers.Dec()
+	}
+}
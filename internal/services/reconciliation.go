@@ -0,0 +1,421 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Holding movement kinds, as returned by ReconciliationRepository.GetHoldingMovements
+const (
+	MovementReward    = "reward"
+	MovementDisposal  = "disposal"
+	MovementForfeit   = "forfeit"
+	MovementSplit     = "split"
+	MovementMergerOut = "merger_out"
+	MovementMergerIn  = "merger_in"
+)
+
+const (
+	// Differences below these are storage rounding, not drift
+	reconcileShareTolerance = 1e-6
+	reconcilePriceTolerance = 0.01
+
+	// reconcileGracePeriod skips repairing holdings with very recent activity, whose
+	// running aggregate may legitimately still be catching up with the event log
+	reconcileGracePeriod = 2 * time.Minute
+
+	// reconcileTimeout bounds a scheduled run
+	reconcileTimeout = 10 * time.Minute
+)
+
+type ReconcileOptions struct {
+	UserID string // Limit the run to one user; empty checks everyone
+	Repair bool   // Overwrite drifted holdings with the recomputed values
+	DryRun bool   // Only report: no run is recorded and nothing is repaired, whatever Repair says
+}
+
+// HoldingMismatch is a holding whose stored aggregate disagrees with the event log
+type HoldingMismatch struct {
+	UserID               string  `json:"user_id"`
+	StockSymbol          string  `json:"stock_symbol"`
+	ActualShares         float64 `json:"actual_shares"`
+	ExpectedShares       float64 `json:"expected_shares"`
+	ActualAveragePrice   float64 `json:"actual_average_price"`
+	ExpectedAveragePrice float64 `json:"expected_average_price"`
+	MissingHolding       bool    `json:"missing_holding"`
+	Repaired             bool    `json:"repaired"`
+	SkipReason           string  `json:"skip_reason,omitempty"`
+}
+
+type ReconciliationReport struct {
+	RunID           string            `json:"run_id,omitempty"` // Empty on a dry run, which records no run
+	UserID          string            `json:"user_id,omitempty"`
+	Repair          bool              `json:"repair"`
+	DryRun          bool              `json:"dry_run"`
+	StartedAt       time.Time         `json:"started_at"`
+	FinishedAt      time.Time         `json:"finished_at"`
+	HoldingsChecked int               `json:"holdings_checked"`
+	Mismatches      []HoldingMismatch `json:"mismatches"`
+	Repaired        int               `json:"repaired"`
+}
+
+// ReconciliationConfig controls the scheduled reconciliation job
+type ReconciliationConfig struct {
+	Interval   time.Duration
+	AutoRepair bool
+}
+
+// ReconciliationService recomputes holdings from reward, disposal, forfeiture and
+// corporate action records and compares them with the user_holdings aggregate
+type ReconciliationService interface {
+	Reconcile(ctx context.Context, opts ReconcileOptions) (*ReconciliationReport, error)
+	Start()
+	Stop()
+}
+
+type reconciliationService struct {
+	txManager          repository.TxManager
+	reconciliationRepo repository.ReconciliationRepository
+	rewardRepo         repository.RewardRepository
+	auditRepo          repository.AuditRepository
+	config             ReconciliationConfig
+	log                *logrus.Logger
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewReconciliationService(
+	txManager repository.TxManager,
+	reconciliationRepo repository.ReconciliationRepository,
+	rewardRepo repository.RewardRepository,
+	auditRepo repository.AuditRepository,
+	config ReconciliationConfig,
+	log *logrus.Logger,
+) ReconciliationService {
+	return &reconciliationService{
+		txManager:          txManager,
+		reconciliationRepo: reconciliationRepo,
+		rewardRepo:         rewardRepo,
+		auditRepo:          auditRepo,
+		config:             config,
+		log:                log,
+		stopCh:             make(chan struct{}),
+	}
+}
+
+type holdingKey struct {
+	userID      string
+	stockSymbol string
+}
+
+// expectedHolding is a holding as replayed from the event log
+type expectedHolding struct {
+	shares       float64
+	averagePrice float64
+	lastActivity time.Time
+}
+
+func (s *reconciliationService) Reconcile(ctx context.Context, opts ReconcileOptions) (_ *ReconciliationReport, err error) {
+	ctx, span := tracer.Start(ctx, "ReconciliationService.Reconcile", trace.WithAttributes(attribute.String("user_id", opts.UserID), attribute.Bool("repair", opts.Repair), attribute.Bool("dry_run", opts.DryRun)))
+	defer func() { tracing.End(span, err) }()
+
+	if opts.DryRun {
+		opts.Repair = false
+	}
+	report := &ReconciliationReport{
+		UserID:     opts.UserID,
+		Repair:     opts.Repair,
+		DryRun:     opts.DryRun,
+		StartedAt:  time.Now(),
+		Mismatches: []HoldingMismatch{},
+	}
+
+	var run *models.ReconciliationRun
+	if !opts.DryRun {
+		report.RunID = uuid.New().String()
+		run = &models.ReconciliationRun{
+			ID:        report.RunID,
+			Scope:     sql.NullString{String: opts.UserID, Valid: opts.UserID != ""},
+			Repair:    opts.Repair,
+			StartedAt: report.StartedAt,
+		}
+		if err := s.reconciliationRepo.CreateRun(ctx, run); err != nil {
+			return nil, fmt.Errorf("failed to record reconciliation run: %w", err)
+		}
+	}
+
+	movements, err := s.reconciliationRepo.GetHoldingMovements(ctx, opts.UserID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holding movements: %w", err)
+	}
+	holdings, err := s.reconciliationRepo.GetHoldings(ctx, opts.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holdings: %w", err)
+	}
+
+	expected := replayMovements(movements)
+	actual := make(map[holdingKey]models.UserHolding, len(holdings))
+	keys := make([]holdingKey, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for _, holding := range holdings {
+		key := holdingKey{holding.UserID, holding.StockSymbol}
+		actual[key] = holding
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].userID != keys[j].userID {
+			return keys[i].userID < keys[j].userID
+		}
+		return keys[i].stockSymbol < keys[j].stockSymbol
+	})
+
+	for _, key := range keys {
+		report.HoldingsChecked++
+
+		holding, hasHolding := actual[key]
+		want := expected[key]
+		mismatch, ok := compareHolding(key, holding, hasHolding, want)
+		if !ok {
+			continue
+		}
+
+		if opts.Repair {
+			if time.Since(want.lastActivity) < reconcileGracePeriod {
+				mismatch.SkipReason = "recent activity"
+			} else if err := s.repairHolding(ctx, report.RunID, key, &mismatch); err != nil {
+				mismatch.SkipReason = err.Error()
+				requestctx.Logger(ctx, s.log).Errorf("Failed to repair holding %s/%s: %v", key.userID, key.stockSymbol, err)
+			}
+		}
+		if mismatch.Repaired {
+			report.Repaired++
+			metrics.ReconciliationRepairs.Inc()
+		}
+		report.Mismatches = append(report.Mismatches, mismatch)
+	}
+
+	report.FinishedAt = time.Now()
+	if run != nil {
+		run.HoldingsChecked = report.HoldingsChecked
+		run.Mismatches = len(report.Mismatches)
+		run.Repaired = report.Repaired
+		run.FinishedAt = sql.NullTime{Time: report.FinishedAt, Valid: true}
+		if err := s.reconciliationRepo.FinishRun(ctx, run); err != nil {
+			return nil, fmt.Errorf("failed to record reconciliation result: %w", err)
+		}
+	}
+
+	if opts.UserID == "" {
+		metrics.ReconciliationMismatches.Set(float64(len(report.Mismatches) - report.Repaired))
+		metrics.ReconciliationLastRun.Set(float64(report.FinishedAt.Unix()))
+	}
+
+	return report, nil
+}
+
+// repairHolding overwrites one holding with the value replayed from the event log.
+// The holding row is locked and the log re-read inside the transaction, so a
+// concurrent disposal either lands entirely before or entirely after the repair.
+func (s *reconciliationService) repairHolding(ctx context.Context, runID string, key holdingKey, mismatch *HoldingMismatch) error {
+	return s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		holding, err := s.rewardRepo.WithTx(tx).GetUserHoldingForUpdate(ctx, key.userID, key.stockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to lock holding: %w", err)
+		}
+
+		movements, err := s.reconciliationRepo.WithTx(tx).GetHoldingMovements(ctx, key.userID, key.stockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to reload movements: %w", err)
+		}
+		want := replayMovements(movements)[key]
+
+		current, stillDrifted := compareHolding(key, derefHolding(holding), holding != nil, want)
+		if !stillDrifted {
+			// Fixed in the meantime; nothing to do
+			return nil
+		}
+
+		repaired := &models.UserHolding{
+			UserID:       key.userID,
+			StockSymbol:  key.stockSymbol,
+			TotalShares:  want.shares,
+			AveragePrice: want.averagePrice,
+			LastUpdated:  time.Now(),
+		}
+		if err := s.rewardRepo.WithTx(tx).UpsertUserHolding(ctx, repaired); err != nil {
+			return fmt.Errorf("failed to update holding: %w", err)
+		}
+
+		audit := &models.HoldingReconciliationAudit{
+			RunID:                runID,
+			UserID:               key.userID,
+			StockSymbol:          key.stockSymbol,
+			RepairedShares:       want.shares,
+			RepairedAveragePrice: want.averagePrice,
+		}
+		if holding != nil {
+			audit.PreviousShares = sql.NullFloat64{Float64: holding.TotalShares, Valid: true}
+			audit.PreviousAveragePrice = sql.NullFloat64{Float64: holding.AveragePrice, Valid: true}
+		}
+		if err := s.reconciliationRepo.WithTx(tx).CreateAudit(ctx, audit); err != nil {
+			return fmt.Errorf("failed to write audit record: %w", err)
+		}
+		entityID := key.userID + ":" + key.stockSymbol
+		if err := RecordAudit(ctx, s.auditRepo.WithTx(tx), AuditHoldingRepair, "user_holding", entityID, holding, repaired); err != nil {
+			return err
+		}
+
+		*mismatch = current
+		mismatch.Repaired = true
+		return nil
+	})
+}
+
+// replayMovements applies movements in order the way the live code maintains
+// user_holdings: rewards blend into a weighted average price, disposals and
+// forfeitures reduce shares and leave the average unchanged. Corporate actions
+// scale a holding (splits), empty it (merger_out) or blend into it (merger_in).
+func replayMovements(movements []models.HoldingMovement) map[holdingKey]expectedHolding {
+	expected := make(map[holdingKey]expectedHolding)
+	for _, m := range movements {
+		key := holdingKey{m.UserID, m.StockSymbol}
+		h := expected[key]
+
+		switch m.Kind {
+		case MovementReward, MovementMergerIn:
+			totalCost := h.shares*h.averagePrice + m.Quantity*m.PricePerShare
+			h.shares = roundToDecimal(h.shares+m.Quantity, 6)
+			if h.shares > 0 {
+				h.averagePrice = roundToDecimal(totalCost/h.shares, 4)
+			}
+		case MovementDisposal, MovementForfeit:
+			h.shares = roundToDecimal(math.Max(0, h.shares-m.Quantity), 6)
+		case MovementSplit:
+			h.shares = roundToDecimal(h.shares*m.ShareFactor, 6)
+			h.averagePrice = roundToDecimal(h.averagePrice/m.ShareFactor, 4)
+		case MovementMergerOut:
+			h.shares = 0
+		}
+
+		if m.At.After(h.lastActivity) {
+			h.lastActivity = m.At
+		}
+		expected[key] = h
+	}
+	return expected
+}
+
+// compareHolding reports whether a stored holding has drifted from its expected value.
+// The average price only matters while shares are held.
+func compareHolding(key holdingKey, holding models.UserHolding, hasHolding bool, want expectedHolding) (HoldingMismatch, bool) {
+	mismatch := HoldingMismatch{
+		UserID:               key.userID,
+		StockSymbol:          key.stockSymbol,
+		ExpectedShares:       want.shares,
+		ExpectedAveragePrice: want.averagePrice,
+		MissingHolding:       !hasHolding,
+	}
+	if hasHolding {
+		mismatch.ActualShares = holding.TotalShares
+		mismatch.ActualAveragePrice = holding.AveragePrice
+	}
+
+	if !hasHolding {
+		return mismatch, want.shares > reconcileShareTolerance
+	}
+	if math.Abs(holding.TotalShares-want.shares) > reconcileShareTolerance {
+		return mismatch, true
+	}
+	if want.shares > reconcileShareTolerance && math.Abs(holding.AveragePrice-want.averagePrice) > reconcilePriceTolerance {
+		return mismatch, true
+	}
+	return mismatch, false
+}
+
+func derefHolding(holding *models.UserHolding) models.UserHolding {
+	if holding == nil {
+		return models.UserHolding{}
+	}
+	return *holding
+}
+
+// Start runs reconciliation across all users in the background every Interval
+func (s *reconciliationService) Start() {
+	ticker := time.NewTicker(s.config.Interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.run()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+
+	s.log.Infof("Holdings reconciliation job started (interval: %s, auto-repair: %t)", s.config.Interval, s.config.AutoRepair)
+}
+
+// Stop signals the reconciliation job to exit and waits for an in-flight run to finish
+func (s *reconciliationService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+
+	s.log.Info("Holdings reconciliation job stopped")
+}
+
+func (s *reconciliationService) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+	ctx = requestctx.WithActor(ctx, "system:reconciliation")
+
+	report, err := s.Reconcile(ctx, ReconcileOptions{Repair: s.config.AutoRepair})
+	if err != nil {
+		s.log.Errorf("Holdings reconciliation failed: %v", err)
+		return
+	}
+
+	for _, m := range report.Mismatches {
+		s.log.WithFields(logrus.Fields{
+			"run_id":                 report.RunID,
+			"user_id":                m.UserID,
+			"stock_symbol":           m.StockSymbol,
+			"actual_shares":          m.ActualShares,
+			"expected_shares":        m.ExpectedShares,
+			"actual_average_price":   m.ActualAveragePrice,
+			"expected_average_price": m.ExpectedAveragePrice,
+			"repaired":               m.Repaired,
+		}).Warn("Holding mismatch")
+	}
+
+	s.log.Infof("Holdings reconciliation %s: checked=%d, mismatches=%d, repaired=%d",
+		report.RunID, report.HoldingsChecked, len(report.Mismatches), report.Repaired)
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// fakeReconciliationRepo serves fixed movements and holdings and counts the runs recorded
+type fakeReconciliationRepo struct {
+	movements []models.HoldingMovement
+	holdings  []models.UserHolding
+	created   int
+	finished  int
+}
+
+func (r *fakeReconciliationRepo) GetHoldingMovements(ctx context.Context, userID, stockSymbol string) ([]models.HoldingMovement, error) {
+	return r.movements, nil
+}
+
+func (r *fakeReconciliationRepo) GetHoldings(ctx context.Context, userID string) ([]models.UserHolding, error) {
+	return r.holdings, nil
+}
+
+func (r *fakeReconciliationRepo) CreateRun(ctx context.Context, run *models.ReconciliationRun) error {
+	r.created++
+	return nil
+}
+
+func (r *fakeReconciliationRepo) FinishRun(ctx context.Context, run *models.ReconciliationRun) error {
+	r.finished++
+	return nil
+}
+
+func (r *fakeReconciliationRepo) CreateAudit(ctx context.Context, audit *models.HoldingReconciliationAudit) error {
+	return nil
+}
+
+func (r *fakeReconciliationRepo) WithTx(tx *sql.Tx) repository.ReconciliationRepository { return r }
+
+func TestReconcileDryRunRecordsNothing(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	// A reward of 10 shares last week, but the holding says 7
+	repo := &fakeReconciliationRepo{
+		movements: []models.HoldingMovement{{
+			UserID: "ravi", StockSymbol: "TCS", Kind: MovementReward,
+			Quantity: 10, PricePerShare: 100, At: time.Now().Add(-7 * 24 * time.Hour),
+		}},
+		holdings: []models.UserHolding{{UserID: "ravi", StockSymbol: "TCS", TotalShares: 7, AveragePrice: 100}},
+	}
+	// No transaction manager: a repair attempt would panic
+	service := NewReconciliationService(nil, repo, nil, nil, ReconciliationConfig{}, log)
+
+	report, err := service.Reconcile(context.Background(), ReconcileOptions{UserID: "ravi", Repair: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if repo.created != 0 || repo.finished != 0 {
+		t.Errorf("dry run recorded %d runs and finished %d, want none", repo.created, repo.finished)
+	}
+	if report.RunID != "" || report.Repair || !report.DryRun {
+		t.Errorf("report run %q, repair %v, dry run %v; want no run, no repair, dry run", report.RunID, report.Repair, report.DryRun)
+	}
+	if len(report.Mismatches) != 1 || report.Repaired != 0 {
+		t.Fatalf("got %d mismatches and %d repaired, want 1 unrepaired", len(report.Mismatches), report.Repaired)
+	}
+	if got := report.Mismatches[0].ExpectedShares; got != 10 {
+		t.Errorf("expected shares %v, want 10", got)
+	}
+
+	if _, err := service.Reconcile(context.Background(), ReconcileOptions{UserID: "ravi"}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if repo.created != 1 || repo.finished != 1 {
+		t.Errorf("a real run recorded %d runs and finished %d, want 1 each", repo.created, repo.finished)
+	}
+}
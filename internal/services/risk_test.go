@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// fakeRiskRepo records the keys locked and the metadata looked up by the risk checks
+type fakeRiskRepo struct {
+	repository.RiskRepository
+	locks    []string
+	metadata []string
+}
+
+func (f *fakeRiskRepo) LockRisk(ctx context.Context, key string) error {
+	f.locks = append(f.locks, key)
+	return nil
+}
+
+func (f *fakeRiskRepo) CountOtherUsers(ctx context.Context, attribute, value, userID string, since time.Time) (int, error) {
+	if attribute == repository.RiskByMetadata {
+		f.metadata = append(f.metadata, value)
+	}
+	return 0, nil
+}
+
+func TestRiskPipelineNormalizesMetadataOnce(t *testing.T) {
+	pipeline := NewRiskPipeline(NewDuplicateMetadataCheck(time.Hour, 3))
+
+	var locks, lookups []string
+	for _, raw := range []string{
+		`{"campaign": "diwali", "referrer": {"id": 1, "tier": "gold"}}`,
+		`{"referrer":{"tier":"gold","id":1.0},"campaign":"diwali"}`,
+		`{"campaign":"holi","campaign":"diwali","referrer":{"id":1e0,"tier":"gold"}}`,
+	} {
+		repo := &fakeRiskRepo{}
+		event := &models.RewardEvent{UserID: "ravi", Metadata: sql.NullString{String: raw, Valid: true}}
+		if _, _, err := pipeline.Evaluate(context.Background(), repo, event); err != nil {
+			t.Fatalf("Evaluate(%s): %v", raw, err)
+		}
+		if event.Metadata.String != raw {
+			t.Errorf("Evaluate changed the reward's metadata to %s", event.Metadata.String)
+		}
+
+		var lock string
+		for _, key := range repo.locks {
+			if strings.HasPrefix(key, "metadata:") {
+				lock = strings.TrimPrefix(key, "metadata:")
+			}
+		}
+		if len(repo.metadata) != 1 {
+			t.Fatalf("%s: %d metadata lookups, want 1", raw, len(repo.metadata))
+		}
+		if lock != repo.metadata[0] {
+			t.Errorf("%s: locked %s but looked up %s", raw, lock, repo.metadata[0])
+		}
+		locks = append(locks, lock)
+		lookups = append(lookups, repo.metadata[0])
+	}
+
+	for i := 1; i < len(locks); i++ {
+		if locks[i] != locks[0] {
+			t.Errorf("equal metadata got different lock keys: %s and %s", locks[0], locks[i])
+		}
+	}
+	if want := `{"campaign":"diwali","referrer":{"id":1,"tier":"gold"}}`; lookups[0] != want {
+		t.Errorf("normalized metadata = %s, want %s", lookups[0], want)
+	}
+}
@@ -1,32 +1,59 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"math"
 	"math/rand"
+	"net"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
 	"github.com/stocky/assignment/internal/models"
 	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// priceUpdateTimeout bounds a single price updater run
+const priceUpdateTimeout = 30 * time.Second
+
+// ledgerRepostGracePeriod leaves recent rewards to their own post-commit ledger posting
+const ledgerRepostGracePeriod = 2 * time.Minute
+
 // StockPriceService handles stock price updates
 type StockPriceService interface {
 	StartPriceUpdater(intervalMinutes int)
-	GetCurrentPrice(symbol string) (float64, error)
-	GetAllCurrentPrices() (map[string]float64, error)
+	Stop()
+	// GetCurrentPrice and GetAllCurrentPrices price stocks on their primary listing
+	GetCurrentPrice(ctx context.Context, symbol string) (float64, error)
+	GetListingPrice(ctx context.Context, symbol, exchange string) (float64, error)
+	GetAllCurrentPrices(ctx context.Context) (map[string]float64, error)
 }
 
 type stockPriceService struct {
 	stockRepo repository.StockRepository
+	hub       PriceHub
 	log       *logrus.Logger
+	
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
 }
 
-func NewStockPriceService(stockRepo repository.StockRepository, log *logrus.Logger) StockPriceService {
+func NewStockPriceService(stockRepo repository.StockRepository, hub PriceHub, log *logrus.Logger) StockPriceService {
 	return &stockPriceService{
 		stockRepo: stockRepo,
+		hub:       hub,
 		log:       log,
+		stopCh:    make(chan struct{}),
 	}
 }
 
@@ -37,47 +64,130 @@ func (s *stockPriceService) StartPriceUpdater(intervalMinutes int) {
 	// Run immediately on start
 	s.updateAllPrices()
 	
+	s.wg.Add(1)
 	go func() {
-		for range ticker.C {
-			s.updateAllPrices()
+		defer s.wg.Done()
+		defer ticker.Stop()
+		
+		for {
+			select {
+			case <-ticker.C:
+				s.updateAllPrices()
+			case <-s.stopCh:
+				return
+			}
 		}
 	}()
 	
 	s.log.Infof("Stock price updater started (interval: %d minutes)", intervalMinutes)
 }
 
+// Stop signals the price updater to exit and waits for an in-flight update to finish
+func (s *stockPriceService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+	
+	s.log.Info("Stock price updater stopped")
+}
+
 func (s *stockPriceService) updateAllPrices() {
 	s.log.Info("Updating stock prices...")
+	startTime := time.Now()
 	
-	// Hardcoded list of stocks (in production, fetch from database)
-	stocks := []string{
-		"RELIANCE", "TCS", "INFY", "HDFCBANK", "ICICIBANK",
-		"HINDUNILVR", "ITC", "BHARTIARTL", "KOTAKBANK", "WIPRO",
-	}
+	// Runs are detached from any request; bound them so a hung DB can't stall Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), priceUpdateTimeout)
+	defer cancel()
 	
 	now := time.Now()
+	listings, err := s.priceListings(ctx)
+	if err != nil {
+		s.log.Errorf("Failed to load listings to price: %v", err)
+		metrics.PriceUpdateRuns.WithLabelValues("failure").Inc()
+		metrics.PriceUpdateLastRun.Set(float64(now.Unix()))
+		return
+	}
+	
 	updatedCount := 0
 	
-	for _, symbol := range stocks {
-		price := s.generateMockPrice(symbol)
+	for _, listing := range listings {
+		price := s.generateMockPrice(listing.symbol)
 		
 		stockPrice := &models.StockPrice{
-			StockSymbol: symbol,
+			StockSymbol: listing.symbol,
+			Exchange:    listing.exchange,
 			Price:       price,
 			Timestamp:   now,
 			Source:      "mock",
 		}
 		
-		if err := s.stockRepo.CreateStockPrice(stockPrice); err != nil {
-			s.log.Errorf("Failed to save price for %s: %v", symbol, err)
+		if err := s.stockRepo.CreateStockPrice(ctx, stockPrice); err != nil {
+			s.log.Errorf("Failed to save price for %s on %s: %v", listing.symbol, listing.exchange, err)
+			metrics.PriceUpdates.WithLabelValues("failure").Inc()
 		} else {
 			updatedCount++
+			metrics.PriceUpdates.WithLabelValues("success").Inc()
+			s.hub.Publish(PriceTick{StockSymbol: listing.symbol, Exchange: listing.exchange, Price: price, Timestamp: now})
 		}
 	}
 	
+	metrics.PriceUpdateDuration.Observe(time.Since(startTime).Seconds())
+	metrics.PriceUpdateLastRun.Set(float64(now.Unix()))
+	switch updatedCount {
+	case len(listings):
+		metrics.PriceUpdateRuns.WithLabelValues("success").Inc()
+		metrics.PriceUpdateLastSuccess.Set(float64(now.Unix()))
+	case 0:
+		metrics.PriceUpdateRuns.WithLabelValues("failure").Inc()
+	default:
+		metrics.PriceUpdateRuns.WithLabelValues("partial").Inc()
+	}
+	
 	s.log.Infof("Updated %d stock prices at %s", updatedCount, now.Format(time.RFC3339))
 }
 
+// priceListing is a listing the price updater prices: a stock and an exchange
+type priceListing struct {
+	symbol   string
+	exchange string
+}
+
+// priceListings returns every active listing of every active stock. A stock with no
+// listings, such as one added without an ISIN, is priced on its primary exchange.
+func (s *stockPriceService) priceListings(ctx context.Context) ([]priceListing, error) {
+	stocks, err := s.stockRepo.ListStocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listings, err := s.stockRepo.ListListings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	
+	exchanges := make(map[string][]string)
+	for _, listing := range listings {
+		if listing.IsActive {
+			exchanges[listing.StockSymbol] = append(exchanges[listing.StockSymbol], listing.Exchange)
+		}
+	}
+	
+	var out []priceListing
+	for _, stock := range stocks {
+		if !stock.IsActive {
+			continue
+		}
+		listed := exchanges[stock.Symbol]
+		if len(listed) == 0 {
+			listed = []string{stock.Exchange}
+		}
+		for _, exchange := range listed {
+			out = append(out, priceListing{symbol: stock.Symbol, exchange: exchange})
+		}
+	}
+	return out, nil
+}
+
 // generateMockPrice generates realistic stock prices
 func (s *stockPriceService) generateMockPrice(symbol string) float64 {
 	// Base prices for Indian stocks (in INR)
@@ -107,52 +217,107 @@ func (s *stockPriceService) generateMockPrice(symbol string) float64 {
 	return float64(int(price*100)) / 100
 }
 
-func (s *stockPriceService) GetCurrentPrice(symbol string) (float64, error) {
-	price, err := s.stockRepo.GetLatestStockPrice(symbol)
+func (s *stockPriceService) GetCurrentPrice(ctx context.Context, symbol string) (float64, error) {
+	price, err := s.stockRepo.GetLatestStockPrice(ctx, symbol)
 	if err != nil {
 		return 0, err
 	}
 	return price.Price, nil
 }
 
-func (s *stockPriceService) GetAllCurrentPrices() (map[string]float64, error) {
-	return s.stockRepo.GetLatestStockPrices()
+func (s *stockPriceService) GetListingPrice(ctx context.Context, symbol, exchange string) (float64, error) {
+	price, err := s.stockRepo.GetLatestListingPrice(ctx, symbol, exchange)
+	if err != nil {
+		return 0, err
+	}
+	return price.Price, nil
+}
+
+func (s *stockPriceService) GetAllCurrentPrices(ctx context.Context) (map[string]float64, error) {
+	latest, err := s.stockRepo.GetLatestStockPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	
+	prices := make(map[string]float64, len(latest))
+	for symbol, price := range latest {
+		prices[symbol] = price.Price
+	}
+	return prices, nil
 }
 
 // RewardService handles reward business logic
 type RewardService interface {
-	CreateReward(req *RewardRequest) (*models.RewardEvent, error)
-	GetTodayStocks(userID string) ([]models.RewardEvent, error)
-	GetHistoricalINR(userID string) (*HistoricalINRResponse, error)
-	GetUserStats(userID string) (*UserStatsResponse, error)
-	GetUserPortfolio(userID string) ([]models.PortfolioItem, error)
+	CreateReward(ctx context.Context, req *RewardRequest) (*models.RewardEvent, error)
+	CreateRewardWithOverride(ctx context.Context, req *RewardRequest, override *BudgetOverride) (*models.RewardEvent, error)
+	ListBudgetOverrides(ctx context.Context, userID string, limit int) ([]models.RewardBudgetOverride, error)
+	ListRewardReviews(ctx context.Context, status string, limit int) ([]models.RewardReview, error)
+	ApproveRewardReview(ctx context.Context, id int64, decision ReviewDecision) (*models.RewardEvent, error)
+	RejectRewardReview(ctx context.Context, id int64, decision ReviewDecision) (*models.RewardReview, error)
+	GetTodayStocks(ctx context.Context, userID string) ([]models.RewardEvent, error)
+	GetHistoricalINR(ctx context.Context, userID string) (*HistoricalINRResponse, error)
+	GetUserStats(ctx context.Context, userID string) (*UserStatsResponse, error)
+	GetUserPortfolio(ctx context.Context, userID string) ([]models.PortfolioItem, error)
+	RepostLedgerEntries(ctx context.Context, limit int, dryRun bool) ([]LedgerRepost, error)
 }
 
 type rewardService struct {
+	txManager     repository.TxManager
 	rewardRepo    repository.RewardRepository
 	stockRepo     repository.StockRepository
 	ledgerRepo    repository.LedgerRepository
+	taxLotRepo    repository.TaxLotRepository
+	vestingRepo   repository.VestingRepository
+	webhookRepo   repository.WebhookRepository
+	budgetRepo    repository.BudgetRepository
+	riskRepo      repository.RiskRepository
+	auditRepo     repository.AuditRepository
 	priceService  StockPriceService
-	feesConfig    FeesConfig
+	feeService    FeeScheduleService
+	budgets       RewardBudgetConfig
+	risk          *RiskPipeline
 	log           *logrus.Logger
 }
 
+// FeesConfig holds the legacy flat rates used as the fallback fee schedule.
+// Everything is in basis points except GST, which is a percentage of brokerage,
+// exchange and SEBI charges.
 type FeesConfig struct {
-	BrokerageFeeBC int
-	STTFeeBC       int
-	GSTFeeBC       int
-	ExchangeFeeBC  int
-	SEBIFeeBC      int
+	BrokerageFeeBP int
+	STTFeeBP       int
+	GSTFeePercent  int
+	ExchangeFeeBP  int
+	SEBIFeeBP      int
 }
 
 type RewardRequest struct {
 	IdempotencyKey string    `json:"idempotency_key" binding:"required"`
 	UserID         string    `json:"user_id" binding:"required"`
-	StockSymbol    string    `json:"stock_symbol" binding:"required"`
+	// Stocky's symbol, or exchange:symbol such as BSE:RELIANCE to price on that listing.
+	// Either this or the ISIN is required.
+	StockSymbol    string    `json:"stock_symbol" binding:"required_without=ISIN"`
+	ISIN           string    `json:"isin,omitempty" binding:"omitempty,len=12"`
 	SharesQuantity float64   `json:"shares_quantity" binding:"required,gt=0"`
 	Reason         string    `json:"reason"`
 	Metadata       string    `json:"metadata"`
 	RewardedAt     time.Time `json:"rewarded_at"`
+	
+	// Where the reward was earned, for the risk checks
+	DeviceID  string `json:"device_id,omitempty" binding:"omitempty,max=255"`
+	IPAddress string `json:"ip_address,omitempty" binding:"omitempty,ip"`
+	
+	// Optional lock-in / vesting; shares can't be sold or transferred until vested
+	Vesting *VestingRequest `json:"vesting"`
+}
+
+// LedgerRepost is a reward whose missing ledger entries were (or, in a dry run, would be) posted
+type LedgerRepost struct {
+	RewardEventID int64   `json:"reward_event_id"`
+	UserID        string  `json:"user_id"`
+	StockSymbol   string  `json:"stock_symbol"`
+	EntryGroupID  string  `json:"entry_group_id"`
+	TotalValue    float64 `json:"total_value"`
+	TotalFees     float64 `json:"total_fees"`
 }
 
 type HistoricalINRResponse struct {
@@ -180,52 +345,118 @@ type StockRewardSummary struct {
 }
 
 func NewRewardService(
+	txManager repository.TxManager,
 	rewardRepo repository.RewardRepository,
 	stockRepo repository.StockRepository,
 	ledgerRepo repository.LedgerRepository,
+	taxLotRepo repository.TaxLotRepository,
+	vestingRepo repository.VestingRepository,
+	webhookRepo repository.WebhookRepository,
+	budgetRepo repository.BudgetRepository,
+	riskRepo repository.RiskRepository,
+	auditRepo repository.AuditRepository,
 	priceService StockPriceService,
-	feesConfig FeesConfig,
+	feeService FeeScheduleService,
+	budgets RewardBudgetConfig,
+	risk *RiskPipeline,
 	log *logrus.Logger,
 ) RewardService {
 	return &rewardService{
+		txManager:    txManager,
 		rewardRepo:   rewardRepo,
 		stockRepo:    stockRepo,
 		ledgerRepo:   ledgerRepo,
+		taxLotRepo:   taxLotRepo,
+		vestingRepo:  vestingRepo,
+		webhookRepo:  webhookRepo,
+		budgetRepo:   budgetRepo,
+		riskRepo:     riskRepo,
+		auditRepo:    auditRepo,
 		priceService: priceService,
-		feesConfig:   feesConfig,
+		feeService:   feeService,
+		budgets:      budgets,
+		risk:         risk,
 		log:          log,
 	}
 }
 
-func (s *rewardService) CreateReward(req *RewardRequest) (*models.RewardEvent, error) {
+func (s *rewardService) CreateReward(ctx context.Context, req *RewardRequest) (*models.RewardEvent, error) {
+	return s.createReward(ctx, req, nil, nil)
+}
+
+// CreateRewardWithOverride issues a reward even if it exceeds its budgets, recording
+// who approved it, why, and which limits it exceeded
+func (s *rewardService) CreateRewardWithOverride(ctx context.Context, req *RewardRequest, override *BudgetOverride) (*models.RewardEvent, error) {
+	if override == nil {
+		return nil, fmt.Errorf("%w: override is required", ErrInvalidBudgetOverride)
+	}
+	if err := validateBudgetOverride(override); err != nil {
+		return nil, err
+	}
+	return s.createReward(ctx, req, override, nil)
+}
+
+func (s *rewardService) ListBudgetOverrides(ctx context.Context, userID string, limit int) ([]models.RewardBudgetOverride, error) {
+	return s.budgetRepo.ListOverrides(ctx, userID, limit)
+}
+
+// createReward runs the risk checks, then the budgets, and persists the reward. Held and
+// denied rewards are recorded for review instead and returned as a RewardHeldError or
+// RewardDeniedError. An approval issues a held reward without re-running the risk checks.
+func (s *rewardService) createReward(ctx context.Context, req *RewardRequest, override *BudgetOverride, approval *reviewApproval) (_ *models.RewardEvent, err error) {
+	ctx, span := tracer.Start(ctx, "RewardService.CreateReward", trace.WithAttributes(attribute.String("user_id", req.UserID), attribute.String("stock_symbol", req.StockSymbol)))
+	defer func() { tracing.End(span, err) }()
+
 	// Check idempotency
-	existingEvent, err := s.rewardRepo.GetRewardEventByIdempotencyKey(req.IdempotencyKey)
+	existingEvent, err := s.rewardRepo.GetRewardEventByIdempotencyKey(ctx, req.IdempotencyKey)
 	if err != nil {
 		return nil, fmt.Errorf("idempotency check failed: %w", err)
 	}
 	if existingEvent != nil {
-		s.log.Infof("Duplicate reward request detected (key: %s), returning existing event", req.IdempotencyKey)
+		requestctx.Logger(ctx, s.log).Infof("Duplicate reward request detected (key: %s), returning existing event", req.IdempotencyKey)
+		metrics.RewardDuplicates.Inc()
+		
+		existingEvent.Vesting, err = s.vestingRepo.GetScheduleByRewardEvent(ctx, existingEvent.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load vesting schedule: %w", err)
+		}
 		return existingEvent, nil
 	}
 	
-	// Validate stock exists
-	stock, err := s.stockRepo.GetStockBySymbol(req.StockSymbol)
+	// A held or denied request keeps its outcome on retry
+	if approval == nil {
+		review, err := s.riskRepo.GetReviewByIdempotencyKey(ctx, req.IdempotencyKey)
+		if err != nil {
+			return nil, fmt.Errorf("idempotency check failed: %w", err)
+		}
+		if review != nil {
+			return nil, reviewOutcome(review)
+		}
+	}
+	
+	// Resolve the stock and the listing to price it on
+	instrument, err := ResolveInstrument(ctx, s.stockRepo, req.StockSymbol, req.ISIN)
 	if err != nil {
-		return nil, fmt.Errorf("invalid stock symbol: %w", err)
+		return nil, err
 	}
+	stock := instrument.Stock
 	if !stock.IsActive {
-		return nil, fmt.Errorf("stock %s is not active (possibly delisted)", req.StockSymbol)
+		return nil, fmt.Errorf("stock %s is not active (possibly delisted)", stock.Symbol)
 	}
 	
 	// Get current stock price
-	currentPrice, err := s.priceService.GetCurrentPrice(req.StockSymbol)
+	currentPrice, err := s.priceService.GetListingPrice(ctx, stock.Symbol, instrument.Exchange)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stock price: %w", err)
 	}
 	
-	// Calculate fees
+	// Calculate fees using the schedule in force at purchase time
+	feeSchedule, err := s.feeService.GetEffectiveSchedule(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
 	totalValue := req.SharesQuantity * currentPrice
-	fees := s.calculateFees(totalValue)
+	fees := calculateFees(feeSchedule, totalValue, TradeBuy)
 	
 	// Set rewarded_at to now if not provided
 	rewardedAt := req.RewardedAt
@@ -235,104 +466,209 @@ func (s *rewardService) CreateReward(req *RewardRequest) (*models.RewardEvent, e
 	
 	// Create reward event
 	event := &models.RewardEvent{
-		IdempotencyKey: req.IdempotencyKey,
-		UserID:         req.UserID,
-		StockSymbol:    req.StockSymbol,
-		SharesQuantity: req.SharesQuantity,
-		PricePerShare:  currentPrice,
-		TotalValue:     totalValue,
-		BrokerageFee:   fees.Brokerage,
-		STTFee:         fees.STT,
-		GSTFee:         fees.GST,
-		ExchangeFee:    fees.Exchange,
-		SEBIFee:        fees.SEBI,
-		TotalFees:      fees.Total,
-		TotalCost:      totalValue + fees.Total,
-		Reason:         req.Reason,
-		Metadata:       sql.NullString{String: req.Metadata, Valid: req.Metadata != ""},
-		RewardedAt:     rewardedAt,
-	}
-	
-	// Save reward event
-	if err := s.rewardRepo.CreateRewardEvent(event); err != nil {
-		return nil, fmt.Errorf("failed to create reward event: %w", err)
-	}
-	
-	// Update user holdings
-	if err := s.updateUserHoldings(event); err != nil {
-		s.log.Errorf("Failed to update user holdings: %v", err)
-		// Don't fail the entire operation, but log the error
+		IdempotencyKey:     req.IdempotencyKey,
+		UserID:             req.UserID,
+		StockSymbol:        stock.Symbol,
+		ISIN:               stock.ISIN,
+		Exchange:           instrument.Exchange,
+		SharesQuantity:     req.SharesQuantity,
+		PricePerShare:      currentPrice,
+		TotalValue:         totalValue,
+		BrokerageFee:       fees.Brokerage,
+		STTFee:             fees.STT,
+		GSTFee:             fees.GST,
+		ExchangeFee:        fees.Exchange,
+		SEBIFee:            fees.SEBI,
+		StampDutyFee:       fees.StampDuty,
+		TotalFees:          fees.Total,
+		TotalCost:          totalValue + fees.Total,
+		Reason:             req.Reason,
+		Metadata:           sql.NullString{String: req.Metadata, Valid: req.Metadata != ""},
+		FeeScheduleVersion: sql.NullInt64{Int64: int64(feeSchedule.Version), Valid: true},
+		RewardedAt:         rewardedAt,
+		DeviceID:           sql.NullString{String: req.DeviceID, Valid: req.DeviceID != ""},
+		RiskDecision:       RiskAllow,
+	}
+	if ip := net.ParseIP(req.IPAddress); ip != nil {
+		event.IPAddress = sql.NullString{String: ip.String(), Valid: true}
+	}
+	if approval != nil {
+		event.RiskDecision = RiskHold
+		event.RiskSignals = approval.review.Signals
+	}
+	
+	// Run the risk checks and budgets, then save reward event, holding, tax lot and the
+	// reward.created outbox event atomically. A held or denied reward saves only its review.
+	var flagged *models.RewardReview
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		riskRepo := s.riskRepo.WithTx(tx)
+		if approval == nil {
+			decision, signals, err := s.risk.Evaluate(ctx, riskRepo, event)
+			if err != nil {
+				return err
+			}
+			event.RiskSignals = signals
+			if decision != RiskAllow {
+				review, err := newRiskReview(req, event, decision, signals)
+				if err != nil {
+					return err
+				}
+				if err := riskRepo.CreateReview(ctx, review); err != nil {
+					return fmt.Errorf("failed to record risk review: %w", err)
+				}
+				flagged = review
+				
+				action := AuditRewardHold
+				if decision == RiskDeny {
+					action = AuditRewardDeny
+				}
+				return RecordAudit(ctx, s.auditRepo.WithTx(tx), action, "reward_review", strconv.FormatInt(review.ID, 10), nil, review)
+			}
+		}
+		
+		budgetRepo := s.budgetRepo.WithTx(tx)
+		breaches, err := enforceRewardBudgets(ctx, budgetRepo, s.budgets, event, override, s.log)
+		if err != nil {
+			return err
+		}
+		
+		rewardRepo := s.rewardRepo.WithTx(tx)
+		if err := rewardRepo.CreateRewardEvent(ctx, event); err != nil {
+			return fmt.Errorf("failed to create reward event: %w", err)
+		}
+		
+		if override != nil {
+			audit := &models.RewardBudgetOverride{
+				RewardEventID: event.ID,
+				UserID:        event.UserID,
+				ApprovedBy:    override.ApprovedBy,
+				Justification: override.Justification,
+				Breaches:      breaches,
+			}
+			if audit.Breaches == nil {
+				audit.Breaches = []models.BudgetBreach{}
+			}
+			if err := budgetRepo.CreateOverride(ctx, audit); err != nil {
+				return fmt.Errorf("failed to record budget override: %w", err)
+			}
+		}
+		
+		if approval != nil {
+			rewardEventID := sql.NullInt64{Int64: event.ID, Valid: true}
+			resolved, err := riskRepo.ResolveReview(ctx, approval.review.ID, ReviewApproved,
+				approval.decision.ReviewedBy, approval.decision.Note, rewardEventID)
+			if err != nil {
+				return fmt.Errorf("failed to resolve reward review: %w", err)
+			}
+			if !resolved {
+				return fmt.Errorf("%w: review %d was resolved concurrently", ErrRewardReviewNotPending, approval.review.ID)
+			}
+		}
+		
+		if err := rewardRepo.ApplyRewardToHolding(ctx, event); err != nil {
+			return fmt.Errorf("failed to update user holding: %w", err)
+		}
+		
+		lot := &models.TaxLot{
+			RewardEventID:     event.ID,
+			UserID:            event.UserID,
+			StockSymbol:       event.StockSymbol,
+			AcquiredAt:        event.RewardedAt,
+			Quantity:          event.SharesQuantity,
+			RemainingQuantity: event.SharesQuantity,
+			CostPerShare:      event.PricePerShare,
+		}
+		if err := s.taxLotRepo.WithTx(tx).CreateTaxLot(ctx, lot); err != nil {
+			return fmt.Errorf("failed to create tax lot: %w", err)
+		}
+		
+		if req.Vesting != nil {
+			schedule, err := buildVestingSchedule(req.Vesting, event)
+			if err != nil {
+				return err
+			}
+			if err := s.vestingRepo.WithTx(tx).CreateVestingSchedule(ctx, schedule); err != nil {
+				return fmt.Errorf("failed to create vesting schedule: %w", err)
+			}
+			event.Vesting = schedule
+		}
+		
+		aggregateID := strconv.FormatInt(event.ID, 10)
+		if err := enqueueOutboxEvent(ctx, s.webhookRepo.WithTx(tx), EventRewardCreated, "reward_event", aggregateID, event); err != nil {
+			return err
+		}
+		
+		auditRepo := s.auditRepo.WithTx(tx)
+		action := AuditRewardCreate
+		if override != nil {
+			action = AuditRewardCreateOverride
+		}
+		if err := RecordAudit(ctx, auditRepo, action, "reward_event", aggregateID, nil, event); err != nil {
+			return err
+		}
+		if approval != nil {
+			approved := *approval.review
+			approved.Status = ReviewApproved
+			approved.RewardEventID = sql.NullInt64{Int64: event.ID, Valid: true}
+			approved.ReviewedBy = sql.NullString{String: approval.decision.ReviewedBy, Valid: true}
+			approved.ReviewNote = sql.NullString{String: approval.decision.Note, Valid: approval.decision.Note != ""}
+			reviewID := strconv.FormatInt(approved.ID, 10)
+			if err := RecordAudit(ctx, auditRepo, AuditRewardReviewApprove, "reward_review", reviewID, approval.review, &approved); err != nil {
+				return err
+			}
+		}
+		
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	
+	if flagged != nil {
+		metrics.RewardRiskDecisions.WithLabelValues(flagged.Decision).Inc()
+		requestctx.Logger(ctx, s.log).WithFields(logrus.Fields{
+			"user_id":   flagged.UserID,
+			"review_id": flagged.ID,
+			"decision":  flagged.Decision,
+			"signals":   describeSignals(flagged.Signals),
+		}).Warn("Reward flagged by risk checks")
+		return nil, reviewOutcome(flagged)
+	}
+	
+	// The event is persisted; don't let a client disconnect abort the bookkeeping below
+	ctx = context.WithoutCancel(ctx)
+	
 	// Create ledger entries
-	if err := s.createLedgerEntries(event); err != nil {
-		s.log.Errorf("Failed to create ledger entries: %v", err)
+	if err := s.createLedgerEntries(ctx, event); err != nil {
+		requestctx.Logger(ctx, s.log).Errorf("Failed to create ledger entries: %v", err)
 		// Don't fail the entire operation, but log the error
 	}
 	
-	s.log.Infof("Reward created: user=%s, stock=%s, shares=%.6f, price=%.2f, total_cost=%.2f",
+	metrics.RewardsCreated.WithLabelValues(event.StockSymbol).Inc()
+	metrics.SharesIssued.WithLabelValues(event.StockSymbol).Add(event.SharesQuantity)
+	metrics.RewardCostINR.WithLabelValues(event.StockSymbol).Add(event.TotalCost)
+	metrics.RewardFeesINR.WithLabelValues(event.StockSymbol).Add(event.TotalFees)
+	if override != nil {
+		metrics.RewardBudgetOverrides.Inc()
+	}
+	if approval == nil {
+		metrics.RewardRiskDecisions.WithLabelValues(RiskAllow).Inc()
+	}
+	
+	requestctx.Logger(ctx, s.log).Infof("Reward created: user=%s, stock=%s, shares=%.6f, price=%.2f, total_cost=%.2f",
 		event.UserID, event.StockSymbol, event.SharesQuantity, event.PricePerShare, event.TotalCost)
 	
 	return event, nil
 }
 
-type Fees struct {
-	Brokerage float64
-	STT       float64
-	GST       float64
-	Exchange  float64
-	SEBI      float64
-	Total     float64
+// rewardLedgerGroupID derives the entry group of a reward's ledger postings from its
+// ID, so a reposted group is recognisable and never duplicates an earlier one
+func rewardLedgerGroupID(rewardEventID int64) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("stocky:reward:%d", rewardEventID))).String()
 }
 
-func (s *rewardService) calculateFees(totalValue float64) Fees {
-	brokerage := totalValue * float64(s.feesConfig.BrokerageFeeBC) / 10000
-	stt := totalValue * float64(s.feesConfig.STTFeeBC) / 10000
-	exchange := totalValue * float64(s.feesConfig.ExchangeFeeBC) / 10000
-	sebi := totalValue * float64(s.feesConfig.SEBIFeeBC) / 10000
-	gst := brokerage * float64(s.feesConfig.GSTFeeBC) / 100
-	
-	total := brokerage + stt + gst + exchange + sebi
-	
-	return Fees{
-		Brokerage: roundToDecimal(brokerage, 4),
-		STT:       roundToDecimal(stt, 4),
-		GST:       roundToDecimal(gst, 4),
-		Exchange:  roundToDecimal(exchange, 4),
-		SEBI:      roundToDecimal(sebi, 4),
-		Total:     roundToDecimal(total, 4),
-	}
-}
-
-func (s *rewardService) updateUserHoldings(event *models.RewardEvent) error {
-	// Get existing holding
-	holding, err := s.rewardRepo.GetUserHolding(event.UserID, event.StockSymbol)
-	if err != nil {
-		return err
-	}
-	
-	if holding == nil {
-		// Create new holding
-		holding = &models.UserHolding{
-			UserID:       event.UserID,
-			StockSymbol:  event.StockSymbol,
-			TotalShares:  event.SharesQuantity,
-			AveragePrice: event.PricePerShare,
-			LastUpdated:  time.Now(),
-		}
-	} else {
-		// Update existing holding with weighted average price
-		totalCost := (holding.TotalShares * holding.AveragePrice) + (event.SharesQuantity * event.PricePerShare)
-		holding.TotalShares += event.SharesQuantity
-		holding.AveragePrice = totalCost / holding.TotalShares
-		holding.LastUpdated = time.Now()
-	}
-	
-	return s.rewardRepo.UpsertUserHolding(holding)
-}
-
-func (s *rewardService) createLedgerEntries(event *models.RewardEvent) error {
-	entryGroupID := fmt.Sprintf("reward-%d", event.ID)
+func (s *rewardService) createLedgerEntries(ctx context.Context, event *models.RewardEvent) error {
+	entryGroupID := rewardLedgerGroupID(event.ID)
 	
 	entries := []models.LedgerEntry{
 		// Debit: Stock Inventory (asset increases)
@@ -363,7 +699,7 @@ func (s *rewardService) createLedgerEntries(event *models.RewardEvent) error {
 			StockSymbol:   sql.NullString{},
 			DebitAmount:   event.TotalFees,
 			CreditAmount:  0,
-			Description:   fmt.Sprintf("Fees: brokerage=%.2f, STT=%.2f, GST=%.2f, exchange=%.2f, SEBI=%.2f", event.BrokerageFee, event.STTFee, event.GSTFee, event.ExchangeFee, event.SEBIFee),
+			Description:   fmt.Sprintf("Fees: brokerage=%.2f, STT=%.2f, GST=%.2f, exchange=%.2f, SEBI=%.2f, stamp_duty=%.2f", event.BrokerageFee, event.STTFee, event.GSTFee, event.ExchangeFee, event.SEBIFee, event.StampDutyFee),
 		},
 		// Credit: Cash (for fees)
 		{
@@ -377,15 +713,59 @@ func (s *rewardService) createLedgerEntries(event *models.RewardEvent) error {
 		},
 	}
 	
-	return s.ledgerRepo.CreateLedgerEntries(entries)
+	// A zero-fee reward has no fee legs; the ledger rejects zero-amount entries
+	posted := entries[:0]
+	for _, entry := range entries {
+		if entry.DebitAmount > 0 || entry.CreditAmount > 0 {
+			posted = append(posted, entry)
+		}
+	}
+	
+	return s.ledgerRepo.CreateLedgerEntries(ctx, posted)
+}
+
+// RepostLedgerEntries posts ledger entries for rewards whose post-commit posting failed.
+// Rewards from the last few minutes are skipped: their posting may still be in flight.
+func (s *rewardService) RepostLedgerEntries(ctx context.Context, limit int, dryRun bool) ([]LedgerRepost, error) {
+	events, err := s.ledgerRepo.GetUnpostedRewards(ctx, time.Now().UTC().Add(-ledgerRepostGracePeriod), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unposted rewards: %w", err)
+	}
+	
+	reposts := make([]LedgerRepost, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		if !dryRun {
+			if err := s.createLedgerEntries(ctx, event); err != nil {
+				return reposts, fmt.Errorf("failed to post ledger entries for reward %d: %w", event.ID, err)
+			}
+		}
+		reposts = append(reposts, LedgerRepost{
+			RewardEventID: event.ID,
+			UserID:        event.UserID,
+			StockSymbol:   event.StockSymbol,
+			EntryGroupID:  rewardLedgerGroupID(event.ID),
+			TotalValue:    event.TotalValue,
+			TotalFees:     event.TotalFees,
+		})
+	}
+	
+	if !dryRun && len(reposts) > 0 {
+		requestctx.Logger(ctx, s.log).Infof("Reposted ledger entries for %d rewards", len(reposts))
+	}
+	
+	return reposts, nil
 }
 
-func (s *rewardService) GetTodayStocks(userID string) ([]models.RewardEvent, error) {
-	return s.rewardRepo.GetTodayRewards(userID)
+func (s *rewardService) GetTodayStocks(ctx context.Context, userID string) ([]models.RewardEvent, error) {
+	return s.rewardRepo.GetTodayRewards(ctx, userID)
 }
 
-func (s *rewardService) GetHistoricalINR(userID string) (*HistoricalINRResponse, error) {
-	rewards, err := s.rewardRepo.GetHistoricalRewards(userID)
+func (s *rewardService) GetHistoricalINR(ctx context.Context, userID string) (_ *HistoricalINRResponse, err error) {
+	ctx, span := tracer.Start(ctx, "RewardService.GetHistoricalINR", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer func() { tracing.End(span, err) }()
+
+	rewards, err := s.rewardRepo.GetHistoricalRewards(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -415,9 +795,12 @@ func (s *rewardService) GetHistoricalINR(userID string) (*HistoricalINRResponse,
 	}, nil
 }
 
-func (s *rewardService) GetUserStats(userID string) (*UserStatsResponse, error) {
+func (s *rewardService) GetUserStats(ctx context.Context, userID string) (_ *UserStatsResponse, err error) {
+	ctx, span := tracer.Start(ctx, "RewardService.GetUserStats", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer func() { tracing.End(span, err) }()
+
 	// Get today's rewards
-	todayRewards, err := s.rewardRepo.GetTodayRewards(userID)
+	todayRewards, err := s.rewardRepo.GetTodayRewards(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -445,15 +828,19 @@ func (s *rewardService) GetUserStats(userID string) (*UserStatsResponse, error)
 		summaries = append(summaries, *summary)
 	}
 	
-	// Calculate portfolio value
-	portfolio, err := s.GetUserPortfolio(userID)
+	// Only the total is needed, so skip the per-holding breakdown and vesting lookups
+	holdings, err := s.rewardRepo.GetUserPortfolio(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := s.priceService.GetAllCurrentPrices(ctx)
 	if err != nil {
 		return nil, err
 	}
 	
 	portfolioValue := 0.0
-	for _, item := range portfolio {
-		portfolioValue += item.CurrentValue
+	for i := range holdings {
+		portfolioValue += holdings[i].TotalShares * currentPriceOrCost(prices, &holdings[i])
 	}
 	
 	return &UserStatsResponse{
@@ -464,25 +851,36 @@ func (s *rewardService) GetUserStats(userID string) (*UserStatsResponse, error)
 	}, nil
 }
 
-func (s *rewardService) GetUserPortfolio(userID string) ([]models.PortfolioItem, error) {
-	holdings, err := s.rewardRepo.GetUserPortfolio(userID)
+func (s *rewardService) GetUserPortfolio(ctx context.Context, userID string) (_ []models.PortfolioItem, err error) {
+	ctx, span := tracer.Start(ctx, "RewardService.GetUserPortfolio", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer func() { tracing.End(span, err) }()
+
+	holdings, err := s.rewardRepo.GetUserPortfolio(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 	
 	// Get current prices
-	prices, err := s.priceService.GetAllCurrentPrices()
+	prices, err := s.priceService.GetAllCurrentPrices(ctx)
 	if err != nil {
 		return nil, err
 	}
 	
+	// Sum locked shares per stock from active vesting schedules
+	schedules, err := s.vestingRepo.GetActiveSchedules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	unvested := make(map[string]float64)
+	for i := range schedules {
+		unvested[schedules[i].StockSymbol] += UnvestedQuantity(&schedules[i], now)
+	}
+	
 	var portfolio []models.PortfolioItem
-	for _, holding := range holdings {
-		currentPrice := prices[holding.StockSymbol]
-		if currentPrice == 0 {
-			// Fallback if price not found
-			currentPrice = holding.AveragePrice
-		}
+	for i := range holdings {
+		holding := &holdings[i]
+		currentPrice := currentPriceOrCost(prices, holding)
 		
 		currentValue := holding.TotalShares * currentPrice
 		totalCost := holding.TotalShares * holding.AveragePrice
@@ -492,33 +890,37 @@ func (s *rewardService) GetUserPortfolio(userID string) ([]models.PortfolioItem,
 			profitLossPct = (profitLoss / totalCost) * 100
 		}
 		
-		// Get company name
-		stock, _ := s.stockRepo.GetStockBySymbol(holding.StockSymbol)
-		companyName := holding.StockSymbol
-		if stock != nil {
-			companyName = stock.CompanyName
-		}
+		unvestedShares := math.Min(unvested[holding.StockSymbol], holding.TotalShares)
 		
 		portfolio = append(portfolio, models.PortfolioItem{
-			StockSymbol:   holding.StockSymbol,
-			CompanyName:   companyName,
-			TotalShares:   roundToDecimal(holding.TotalShares, 6),
-			AveragePrice:  roundToDecimal(holding.AveragePrice, 2),
-			CurrentPrice:  roundToDecimal(currentPrice, 2),
-			CurrentValue:  roundToDecimal(currentValue, 2),
-			TotalCost:     roundToDecimal(totalCost, 2),
-			ProfitLoss:    roundToDecimal(profitLoss, 2),
-			ProfitLossPct: roundToDecimal(profitLossPct, 2),
+			StockSymbol:    holding.StockSymbol,
+			CompanyName:    holding.CompanyName,
+			Exchange:       holding.Exchange,
+			TotalShares:    roundToDecimal(holding.TotalShares, 6),
+			AveragePrice:   roundToDecimal(holding.AveragePrice, 2),
+			CurrentPrice:   roundToDecimal(currentPrice, 2),
+			CurrentValue:   roundToDecimal(currentValue, 2),
+			TotalCost:      roundToDecimal(totalCost, 2),
+			ProfitLoss:     roundToDecimal(profitLoss, 2),
+			ProfitLossPct:  roundToDecimal(profitLossPct, 2),
+			VestedShares:   roundToDecimal(holding.TotalShares-unvestedShares, 6),
+			UnvestedShares: roundToDecimal(unvestedShares, 6),
 		})
 	}
 	
 	return portfolio, nil
 }
 
-func roundToDecimal(value float64, decimals int) float64 {
-	multiplier := 1.0
-	for i := 0; i < decimals; i++ {
-		multiplier *= 10
+// currentPriceOrCost values a holding at the latest price, falling back to its average cost
+func currentPriceOrCost(prices map[string]float64, holding *models.PortfolioHolding) float64 {
+	if price := prices[holding.StockSymbol]; price > 0 {
+		return price
 	}
-	return float64(int(value*multiplier+0.5)) / multiplier
+	return holding.AveragePrice
+}
+
+// roundToDecimal rounds half away from zero, so losses round like gains
+func roundToDecimal(value float64, decimals int) float64 {
+	multiplier := math.Pow(10, float64(decimals))
+	return math.Round(value*multiplier) / multiplier
 }
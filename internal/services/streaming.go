@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+)
+
+// Stream event types, used as the SSE event name
+const (
+	StreamEventPrice     = "price"
+	StreamEventPortfolio = "portfolio"
+)
+
+type StreamEvent struct {
+	Type string
+	Data interface{}
+}
+
+// PortfolioSnapshot is the payload of a portfolio stream event
+type PortfolioSnapshot struct {
+	UserID        string                 `json:"user_id"`
+	TotalValueINR float64                `json:"total_value_inr"`
+	Holdings      []models.PortfolioItem `json:"holdings"`
+	Timestamp     time.Time              `json:"timestamp"`
+}
+
+// StreamConfig controls per-connection buffering and how often portfolio values are recomputed
+type StreamConfig struct {
+	SubscriberBuffer  int           // Ticks buffered per connection before the oldest is dropped
+	PortfolioDebounce time.Duration // Quiet period after a burst of ticks before revaluing the portfolio
+}
+
+// StreamingService produces live price and portfolio events for a user
+type StreamingService interface {
+	// StreamUser emits an initial portfolio snapshot, then price ticks for held
+	// symbols and a fresh snapshot after each burst of ticks. Only ticks of the
+	// listing a holding is valued on are emitted, so streamed prices match the
+	// snapshot. The channel is
+	// closed when ctx is done or the hub shuts down.
+	StreamUser(ctx context.Context, userID string) (<-chan StreamEvent, error)
+}
+
+type streamingService struct {
+	hub           PriceHub
+	rewardService RewardService
+	config        StreamConfig
+	log           *logrus.Logger
+}
+
+func NewStreamingService(hub PriceHub, rewardService RewardService, config StreamConfig, log *logrus.Logger) StreamingService {
+	return &streamingService{
+		hub:           hub,
+		rewardService: rewardService,
+		config:        config,
+		log:           log,
+	}
+}
+
+func (s *streamingService) StreamUser(ctx context.Context, userID string) (<-chan StreamEvent, error) {
+	snapshot, held, err := s.portfolioSnapshot(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load portfolio: %w", err)
+	}
+
+	ticks, unsubscribe := s.hub.Subscribe(s.config.SubscriberBuffer)
+	events := make(chan StreamEvent, s.config.SubscriberBuffer)
+
+	go func() {
+		defer close(events)
+		defer unsubscribe()
+
+		emit := func(event StreamEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !emit(StreamEvent{Type: StreamEventPortfolio, Data: snapshot}) {
+			return
+		}
+
+		// Revalue once per burst instead of once per tick; the updater writes every symbol in one run
+		var debounce *time.Timer
+		var debounceC <-chan time.Time
+		defer func() {
+			if debounce != nil {
+				debounce.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case tick, ok := <-ticks:
+				if !ok {
+					return
+				}
+				if valuedOn(held, tick) && !emit(StreamEvent{Type: StreamEventPrice, Data: tick}) {
+					return
+				}
+				// Any tick can matter: the user may have been rewarded a new symbol since the last snapshot
+				if debounceC == nil {
+					debounce = time.NewTimer(s.config.PortfolioDebounce)
+					debounceC = debounce.C
+				}
+
+			case <-debounceC:
+				debounceC = nil
+				snapshot, held, err = s.portfolioSnapshot(ctx, userID)
+				if err != nil {
+					if ctx.Err() == nil {
+						s.log.Warnf("Failed to refresh streamed portfolio for %s: %v", userID, err)
+					}
+					continue
+				}
+				if !emit(StreamEvent{Type: StreamEventPortfolio, Data: snapshot}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// valuedOn reports whether tick is a price of the listing a held symbol is valued
+// on. Ticks of other listings of the same stock would disagree with the snapshot.
+func valuedOn(held map[string]string, tick PriceTick) bool {
+	exchange, ok := held[tick.StockSymbol]
+	return ok && exchange != "" && exchange == tick.Exchange
+}
+
+// portfolioSnapshot values the portfolio and returns the listing each held symbol
+// is valued on
+func (s *streamingService) portfolioSnapshot(ctx context.Context, userID string) (*PortfolioSnapshot, map[string]string, error) {
+	portfolio, err := s.rewardService.GetUserPortfolio(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	held := make(map[string]string, len(portfolio))
+	total := 0.0
+	for _, item := range portfolio {
+		held[item.StockSymbol] = item.Exchange
+		total += item.CurrentValue
+	}
+
+	snapshot := &PortfolioSnapshot{
+		UserID:        userID,
+		TotalValueINR: roundToDecimal(total, 2),
+		Holdings:      portfolio,
+		Timestamp:     time.Now(),
+	}
+	return snapshot, held, nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+)
+
+// fakePortfolioService serves a fixed portfolio to the stream
+type fakePortfolioService struct {
+	RewardService
+	portfolio []models.PortfolioItem
+}
+
+func (f *fakePortfolioService) GetUserPortfolio(ctx context.Context, userID string) ([]models.PortfolioItem, error) {
+	return f.portfolio, nil
+}
+
+func TestStreamUserSendsOnlyPrimaryListingTicks(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	hub := NewPriceHub()
+	defer hub.Close()
+
+	rewardService := &fakePortfolioService{portfolio: []models.PortfolioItem{
+		{StockSymbol: "RELIANCE", Exchange: ExchangeNSE, TotalShares: 2, CurrentPrice: 2500, CurrentValue: 5000},
+		{StockSymbol: "TCS", Exchange: ExchangeBSE, TotalShares: 1, CurrentPrice: 3500, CurrentValue: 3500},
+		{StockSymbol: "GONE", TotalShares: 1, CurrentPrice: 10, CurrentValue: 10}, // Missing from stocks
+	}}
+	// A long debounce keeps portfolio refreshes out of the way
+	streaming := NewStreamingService(hub, rewardService, StreamConfig{SubscriberBuffer: 16, PortfolioDebounce: time.Hour}, log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := streaming.StreamUser(ctx, "ravi")
+	if err != nil {
+		t.Fatalf("StreamUser: %v", err)
+	}
+	if first := <-events; first.Type != StreamEventPortfolio {
+		t.Fatalf("first event = %s, want portfolio", first.Type)
+	}
+
+	now := time.Now()
+	for _, tick := range []PriceTick{
+		{StockSymbol: "RELIANCE", Exchange: ExchangeBSE, Price: 2490, Timestamp: now},
+		{StockSymbol: "RELIANCE", Exchange: ExchangeNSE, Price: 2510, Timestamp: now},
+		{StockSymbol: "TCS", Exchange: ExchangeNSE, Price: 3480, Timestamp: now},
+		{StockSymbol: "TCS", Exchange: ExchangeBSE, Price: 3520, Timestamp: now},
+		{StockSymbol: "GONE", Exchange: ExchangeNSE, Price: 11, Timestamp: now},
+		{StockSymbol: "INFY", Exchange: ExchangeNSE, Price: 1500, Timestamp: now},
+	} {
+		hub.Publish(tick)
+	}
+
+	want := []PriceTick{
+		{StockSymbol: "RELIANCE", Exchange: ExchangeNSE, Price: 2510, Timestamp: now},
+		{StockSymbol: "TCS", Exchange: ExchangeBSE, Price: 3520, Timestamp: now},
+	}
+	for i, w := range want {
+		select {
+		case event := <-events:
+			tick, ok := event.Data.(PriceTick)
+			if event.Type != StreamEventPrice || !ok || tick != w {
+				t.Fatalf("event %d = %s %+v, want price %+v", i, event.Type, event.Data, w)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for tick %+v", w)
+		}
+	}
+
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event %s %+v", event.Type, event.Data)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TermShort = "short"
+	TermLong  = "long"
+)
+
+// ist is the timezone Indian financial years are defined in
+var ist = time.FixedZone("IST", 5*60*60+30*60)
+
+// ErrInvalidFinancialYear is returned for malformed financial year labels
+var ErrInvalidFinancialYear = errors.New("invalid financial year")
+
+// TaxService produces per-user, per-financial-year tax reports from tax lots
+type TaxService interface {
+	GetTaxReport(ctx context.Context, userID, financialYear string) (*TaxReport, error)
+}
+
+type TaxReport struct {
+	UserID               string             `json:"user_id"`
+	FinancialYear        string             `json:"financial_year"`
+	PeriodStart          string             `json:"period_start"`
+	PeriodEnd            string             `json:"period_end"`
+	Perquisites          []PerquisiteEntry  `json:"perquisites"`
+	TotalPerquisiteValue float64            `json:"total_perquisite_value"`
+	CapitalGains         []CapitalGainEntry `json:"capital_gains"`
+	ShortTermGains       float64            `json:"short_term_capital_gains"`
+	LongTermGains        float64            `json:"long_term_capital_gains"`
+	GeneratedAt          string             `json:"generated_at"`
+}
+
+// PerquisiteEntry is reward income: shares received valued at FMV on the reward date.
+// Shares forfeited before vesting were never received and are not income.
+type PerquisiteEntry struct {
+	RewardEventID     int64   `json:"reward_event_id"`
+	StockSymbol       string  `json:"stock_symbol"`
+	AcquiredOn        string  `json:"acquired_on"`
+	Quantity          float64 `json:"quantity"`
+	ForfeitedQuantity float64 `json:"forfeited_quantity,omitempty"`
+	FMVPerShare       float64 `json:"fmv_per_share"`
+	Value             float64 `json:"value"`
+}
+
+// CapitalGainEntry is the gain on the part of one lot consumed by a disposal
+type CapitalGainEntry struct {
+	TaxLotID    int64   `json:"tax_lot_id"`
+	StockSymbol string  `json:"stock_symbol"`
+	Quantity    float64 `json:"quantity"`
+	AcquiredOn  string  `json:"acquired_on"`
+	DisposedOn  string  `json:"disposed_on"`
+	HoldingDays int     `json:"holding_days"`
+	Term        string  `json:"term"`
+	CostBasis   float64 `json:"cost_basis"`
+	Proceeds    float64 `json:"proceeds"`
+	Gain        float64 `json:"gain"`
+}
+
+type taxService struct {
+	taxLotRepo repository.TaxLotRepository
+	log        *logrus.Logger
+}
+
+func NewTaxService(taxLotRepo repository.TaxLotRepository, log *logrus.Logger) TaxService {
+	return &taxService{
+		taxLotRepo: taxLotRepo,
+		log:        log,
+	}
+}
+
+func (s *taxService) GetTaxReport(ctx context.Context, userID, financialYear string) (_ *TaxReport, err error) {
+	ctx, span := tracer.Start(ctx, "TaxService.GetTaxReport", trace.WithAttributes(attribute.String("user_id", userID), attribute.String("financial_year", financialYear)))
+	defer func() { tracing.End(span, err) }()
+
+	start, end, label, err := ParseFinancialYear(financialYear, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := s.taxLotRepo.GetLotsAcquiredBetween(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tax lots: %w", err)
+	}
+
+	disposals, err := s.taxLotRepo.GetLotDisposalsBetween(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lot disposals: %w", err)
+	}
+
+	report := &TaxReport{
+		UserID:        userID,
+		FinancialYear: label,
+		PeriodStart:   start.Format("2006-01-02"),
+		PeriodEnd:     end.AddDate(0, 0, -1).Format("2006-01-02"),
+		Perquisites:   []PerquisiteEntry{},
+		CapitalGains:  []CapitalGainEntry{},
+		GeneratedAt:   time.Now().Format(time.RFC3339),
+	}
+
+	perquisiteTotal := 0.0
+	for _, lot := range lots {
+		quantity := lot.Quantity - lot.ForfeitedQuantity
+		if quantity <= 0 {
+			continue
+		}
+		value := quantity * lot.CostPerShare
+		perquisiteTotal += value
+		report.Perquisites = append(report.Perquisites, PerquisiteEntry{
+			RewardEventID:     lot.RewardEventID,
+			StockSymbol:       lot.StockSymbol,
+			AcquiredOn:        lot.AcquiredAt.In(ist).Format("2006-01-02"),
+			Quantity:          roundToDecimal(quantity, 6),
+			ForfeitedQuantity: roundToDecimal(lot.ForfeitedQuantity, 6),
+			FMVPerShare:       roundToDecimal(lot.CostPerShare, 4),
+			Value:             roundToDecimal(value, 2),
+		})
+	}
+
+	shortTerm, longTerm := 0.0, 0.0
+	for _, d := range disposals {
+		costBasis := d.Quantity * d.CostPerShare
+		proceeds := d.Quantity * d.ProceedsPerShare
+		gain := proceeds - costBasis
+		term := holdingTerm(d.AcquiredAt, d.DisposedAt)
+
+		if term == TermLong {
+			longTerm += gain
+		} else {
+			shortTerm += gain
+		}
+
+		report.CapitalGains = append(report.CapitalGains, CapitalGainEntry{
+			TaxLotID:    d.TaxLotID,
+			StockSymbol: d.StockSymbol,
+			Quantity:    roundToDecimal(d.Quantity, 6),
+			AcquiredOn:  d.AcquiredAt.In(ist).Format("2006-01-02"),
+			DisposedOn:  d.DisposedAt.In(ist).Format("2006-01-02"),
+			HoldingDays: int(d.DisposedAt.Sub(d.AcquiredAt).Hours() / 24),
+			Term:        term,
+			CostBasis:   roundToDecimal(costBasis, 2),
+			Proceeds:    roundToDecimal(proceeds, 2),
+			Gain:        roundToDecimal(gain, 2),
+		})
+	}
+
+	report.TotalPerquisiteValue = roundToDecimal(perquisiteTotal, 2)
+	report.ShortTermGains = roundToDecimal(shortTerm, 2)
+	report.LongTermGains = roundToDecimal(longTerm, 2)
+
+	return report, nil
+}
+
+// holdingTerm classifies listed equity held for more than 12 months as long-term
+func holdingTerm(acquiredAt, disposedAt time.Time) string {
+	if disposedAt.After(acquiredAt.AddDate(1, 0, 0)) {
+		return TermLong
+	}
+	return TermShort
+}
+
+// ParseFinancialYear accepts "2024-25", "FY2024-25" or "2024" (the year the
+// FY starts in) and returns its [1 April, 1 April next year) bounds in IST.
+// An empty label selects the financial year containing now.
+func ParseFinancialYear(label string, now time.Time) (time.Time, time.Time, string, error) {
+	var startYear int
+
+	label = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(label)), "FY")
+	if label == "" {
+		now = now.In(ist)
+		startYear = now.Year()
+		if now.Month() < time.April {
+			startYear--
+		}
+	} else {
+		first, second, hasSecond := strings.Cut(label, "-")
+		year, err := strconv.Atoi(first)
+		if err != nil || year < 1900 || year > 9999 {
+			return time.Time{}, time.Time{}, "", fmt.Errorf("%w: %q", ErrInvalidFinancialYear, label)
+		}
+		if hasSecond && second != fmt.Sprintf("%02d", (year+1)%100) && second != strconv.Itoa(year+1) {
+			return time.Time{}, time.Time{}, "", fmt.Errorf("%w: %q does not span consecutive years", ErrInvalidFinancialYear, label)
+		}
+		startYear = year
+	}
+
+	start := time.Date(startYear, time.April, 1, 0, 0, 0, 0, ist)
+	end := start.AddDate(1, 0, 0)
+	return start, end, fmt.Sprintf("%d-%02d", startYear, (startYear+1)%100), nil
+}
+
+// WriteCSV writes the report as a flat CSV, one row per perquisite and
+// capital gain entry followed by summary rows
+func (r *TaxReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{
+		"record_type", "reference_id", "stock_symbol", "quantity", "acquired_on",
+		"disposed_on", "holding_days", "term", "cost_basis", "proceeds", "amount",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	money := func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+	qty := func(v float64) string { return strconv.FormatFloat(v, 'f', 6, 64) }
+
+	for _, p := range r.Perquisites {
+		row := []string{
+			"perquisite", strconv.FormatInt(p.RewardEventID, 10), p.StockSymbol, qty(p.Quantity),
+			p.AcquiredOn, "", "", "", money(p.Value), "", money(p.Value),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range r.CapitalGains {
+		row := []string{
+			"capital_gain", strconv.FormatInt(g.TaxLotID, 10), g.StockSymbol, qty(g.Quantity),
+			g.AcquiredOn, g.DisposedOn, strconv.Itoa(g.HoldingDays), g.Term,
+			money(g.CostBasis), money(g.Proceeds), money(g.Gain),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	summaries := [][2]string{
+		{"total_perquisite_value", money(r.TotalPerquisiteValue)},
+		{"short_term_capital_gains", money(r.ShortTermGains)},
+		{"long_term_capital_gains", money(r.LongTermGains)},
+	}
+	for _, sm := range summaries {
+		if err := cw.Write([]string{sm[0], "", "", "", "", "", "", "", "", "", sm[1]}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
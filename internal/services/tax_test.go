@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// fakeTaxLotRepo serves fixed lots and disposals to the tax report
+type fakeTaxLotRepo struct {
+	repository.TaxLotRepository
+	lots      []models.TaxLot
+	disposals []models.LotDisposal
+}
+
+func (f *fakeTaxLotRepo) GetLotsAcquiredBetween(ctx context.Context, userID string, from, to time.Time) ([]models.TaxLot, error) {
+	return f.lots, nil
+}
+
+func (f *fakeTaxLotRepo) GetLotDisposalsBetween(ctx context.Context, userID string, from, to time.Time) ([]models.LotDisposal, error) {
+	return f.disposals, nil
+}
+
+func newTestTaxService(repo *fakeTaxLotRepo) TaxService {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	return NewTaxService(repo, log)
+}
+
+func TestTaxReportExcludesForfeitedShares(t *testing.T) {
+	acquired := time.Date(2024, 6, 10, 10, 0, 0, 0, ist)
+	repo := &fakeTaxLotRepo{lots: []models.TaxLot{
+		{RewardEventID: 1, StockSymbol: "TCS", AcquiredAt: acquired, Quantity: 10, CostPerShare: 100},
+		{RewardEventID: 2, StockSymbol: "INFY", AcquiredAt: acquired, Quantity: 10, CostPerShare: 50, ForfeitedQuantity: 4},
+		{RewardEventID: 3, StockSymbol: "WIPRO", AcquiredAt: acquired, Quantity: 5, CostPerShare: 200, ForfeitedQuantity: 5},
+	}}
+
+	report, err := newTestTaxService(repo).GetTaxReport(context.Background(), "ravi", "2024-25")
+	if err != nil {
+		t.Fatalf("GetTaxReport: %v", err)
+	}
+
+	if len(report.Perquisites) != 2 {
+		t.Fatalf("got %d perquisites, want 2 (the fully forfeited reward is no income): %+v", len(report.Perquisites), report.Perquisites)
+	}
+	partial := report.Perquisites[1]
+	if partial.Quantity != 6 || partial.ForfeitedQuantity != 4 || partial.Value != 300 {
+		t.Errorf("partly forfeited reward = %+v, want quantity 6, forfeited 4, value 300", partial)
+	}
+	if report.TotalPerquisiteValue != 1300 {
+		t.Errorf("TotalPerquisiteValue = %.2f, want 1300", report.TotalPerquisiteValue)
+	}
+}
+
+func TestRoundToDecimalNegative(t *testing.T) {
+	cases := []struct {
+		value    float64
+		decimals int
+		want     float64
+	}{
+		{1.006, 2, 1.01},
+		{-1.006, 2, -1.01},
+		{-0.006, 2, -0.01},
+		{-0.004, 2, 0},
+		{-12.3456, 2, -12.35},
+		{-0.0000004, 6, 0},
+		{-2.5, 0, -3},
+	}
+	for _, tc := range cases {
+		if got := roundToDecimal(tc.value, tc.decimals); got != tc.want {
+			t.Errorf("roundToDecimal(%v, %d) = %v, want %v", tc.value, tc.decimals, got, tc.want)
+		}
+	}
+}
+
+func TestTaxReportRealizedLosses(t *testing.T) {
+	acquired := time.Date(2023, 5, 2, 10, 0, 0, 0, ist)
+	repo := &fakeTaxLotRepo{disposals: []models.LotDisposal{
+		// Short-term loss of 1.006 on one share
+		{TaxLotID: 1, StockSymbol: "TCS", Quantity: 1, AcquiredAt: time.Date(2024, 5, 2, 10, 0, 0, 0, ist),
+			DisposedAt: time.Date(2024, 9, 2, 10, 0, 0, 0, ist), CostPerShare: 100, ProceedsPerShare: 98.994},
+		// Long-term loss of 0.006 on one share
+		{TaxLotID: 2, StockSymbol: "INFY", Quantity: 1, AcquiredAt: acquired,
+			DisposedAt: time.Date(2024, 9, 2, 10, 0, 0, 0, ist), CostPerShare: 50, ProceedsPerShare: 49.994},
+	}}
+
+	report, err := newTestTaxService(repo).GetTaxReport(context.Background(), "ravi", "2024-25")
+	if err != nil {
+		t.Fatalf("GetTaxReport: %v", err)
+	}
+
+	if got := report.CapitalGains[0].Gain; got != -1.01 {
+		t.Errorf("short-term entry gain = %v, want -1.01", got)
+	}
+	if got := report.CapitalGains[1].Gain; got != -0.01 {
+		t.Errorf("long-term entry gain = %v, want -0.01", got)
+	}
+	if report.ShortTermGains != -1.01 || report.LongTermGains != -0.01 {
+		t.Errorf("short-term %v, long-term %v; want -1.01 and -0.01", report.ShortTermGains, report.LongTermGains)
+	}
+}
@@ -0,0 +1,7 @@
+package services
+
+import "github.com/stocky/assignment/internal/tracing"
+
+// tracer starts the service-layer spans. They hang under the request's HTTP span,
+// and the SQL driver's query spans hang under them.
+var tracer = tracing.Tracer("github.com/stocky/assignment/internal/services")
@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+	"github.com/stocky/assignment/internal/requestctx"
+	"github.com/stocky/assignment/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	VestingCliff   = "cliff"
+	VestingLinear  = "linear"
+	VestingTranche = "tranche"
+
+	VestingStatusActive    = "active"
+	VestingStatusForfeited = "forfeited"
+)
+
+var (
+	ErrInvalidVesting           = errors.New("invalid vesting schedule")
+	ErrRewardNotFound           = errors.New("reward not found")
+	ErrRewardAlreadyInvalidated = errors.New("reward already invalidated")
+)
+
+// VestingRequest describes a vesting schedule relative to the reward date
+type VestingRequest struct {
+	Type         string                  `json:"type" binding:"required,oneof=cliff linear tranche"`
+	CliffDays    int                     `json:"cliff_days"`    // cliff: lock-in length; linear: optional cliff
+	DurationDays int                     `json:"duration_days"` // linear: days until fully vested
+	Tranches     []VestingTrancheRequest `json:"tranches"`      // tranche: must sum to 100 percent
+}
+
+type VestingTrancheRequest struct {
+	AfterDays int     `json:"after_days"`
+	Percent   float64 `json:"percent"`
+}
+
+// VestingService handles reward invalidation and forfeiture of unvested shares
+type VestingService interface {
+	InvalidateReward(ctx context.Context, rewardEventID int64, reason string) (*InvalidationResult, error)
+}
+
+type InvalidationResult struct {
+	RewardEventID     int64                   `json:"reward_event_id"`
+	ForfeitedQuantity float64                 `json:"forfeited_quantity"`
+	RetainedQuantity  float64                 `json:"retained_quantity"`
+	Vesting           *models.VestingSchedule `json:"vesting,omitempty"`
+}
+
+type vestingService struct {
+	txManager   repository.TxManager
+	rewardRepo  repository.RewardRepository
+	vestingRepo repository.VestingRepository
+	taxLotRepo  repository.TaxLotRepository
+	ledgerRepo  repository.LedgerRepository
+	webhookRepo repository.WebhookRepository
+	auditRepo   repository.AuditRepository
+	log         *logrus.Logger
+}
+
+func NewVestingService(
+	txManager repository.TxManager,
+	rewardRepo repository.RewardRepository,
+	vestingRepo repository.VestingRepository,
+	taxLotRepo repository.TaxLotRepository,
+	ledgerRepo repository.LedgerRepository,
+	webhookRepo repository.WebhookRepository,
+	auditRepo repository.AuditRepository,
+	log *logrus.Logger,
+) VestingService {
+	return &vestingService{
+		txManager:   txManager,
+		rewardRepo:  rewardRepo,
+		vestingRepo: vestingRepo,
+		taxLotRepo:  taxLotRepo,
+		ledgerRepo:  ledgerRepo,
+		webhookRepo: webhookRepo,
+		auditRepo:   auditRepo,
+		log:         log,
+	}
+}
+
+// InvalidateReward flags a reward as invalid and forfeits whatever has not
+// vested yet. Shares that already vested stay with the user.
+func (s *vestingService) InvalidateReward(ctx context.Context, rewardEventID int64, reason string) (_ *InvalidationResult, err error) {
+	ctx, span := tracer.Start(ctx, "VestingService.InvalidateReward", trace.WithAttributes(attribute.Int64("reward_event_id", rewardEventID)))
+	defer func() { tracing.End(span, err) }()
+
+	result := &InvalidationResult{RewardEventID: rewardEventID}
+	now := time.Now()
+
+	err = s.txManager.WithinTx(ctx, func(tx *sql.Tx) error {
+		event, err := s.rewardRepo.WithTx(tx).GetRewardEventByIDForUpdate(ctx, rewardEventID)
+		if err != nil {
+			return fmt.Errorf("failed to load reward: %w", err)
+		}
+		if event == nil {
+			return fmt.Errorf("%w: %d", ErrRewardNotFound, rewardEventID)
+		}
+		if event.InvalidatedAt.Valid {
+			return fmt.Errorf("%w: %d", ErrRewardAlreadyInvalidated, rewardEventID)
+		}
+
+		// Lock the holding before touching lots, matching the disposal lock order
+		holding, err := s.rewardRepo.WithTx(tx).GetUserHoldingForUpdate(ctx, event.UserID, event.StockSymbol)
+		if err != nil {
+			return fmt.Errorf("failed to lock holding: %w", err)
+		}
+
+		if err := s.rewardRepo.WithTx(tx).InvalidateRewardEvent(ctx, rewardEventID, reason); err != nil {
+			return fmt.Errorf("failed to invalidate reward: %w", err)
+		}
+
+		schedule, err := s.vestingRepo.WithTx(tx).GetScheduleByRewardEvent(ctx, rewardEventID)
+		if err != nil {
+			return fmt.Errorf("failed to load vesting schedule: %w", err)
+		}
+		if schedule == nil || schedule.Status != VestingStatusActive {
+			// Nothing is locked, so nothing can be clawed back
+			result.RetainedQuantity = event.SharesQuantity
+			result.Vesting = schedule
+			return s.recordInvalidation(ctx, tx, event, result, reason, now)
+		}
+
+		forfeit := roundToDecimal(UnvestedQuantity(schedule, now), 6)
+
+		lot, err := s.taxLotRepo.WithTx(tx).GetLotByRewardEventForUpdate(ctx, rewardEventID)
+		if err != nil {
+			return fmt.Errorf("failed to lock tax lot: %w", err)
+		}
+		if lot != nil {
+			forfeit = math.Min(forfeit, lot.RemainingQuantity)
+		}
+		if holding != nil {
+			forfeit = math.Min(forfeit, holding.TotalShares)
+		}
+
+		if err := s.vestingRepo.WithTx(tx).MarkForfeited(ctx, schedule.ID, forfeit, reason, now); err != nil {
+			return fmt.Errorf("failed to mark schedule forfeited: %w", err)
+		}
+
+		if forfeit > 0 {
+			if lot != nil {
+				if err := s.taxLotRepo.WithTx(tx).ConsumeLot(ctx, lot.ID, forfeit); err != nil {
+					return fmt.Errorf("failed to reduce tax lot: %w", err)
+				}
+			}
+			if holding != nil {
+				if err := s.rewardRepo.WithTx(tx).DecrementUserHolding(ctx, event.UserID, event.StockSymbol, forfeit); err != nil {
+					return fmt.Errorf("failed to update holding: %w", err)
+				}
+			}
+			if err := s.ledgerRepo.WithTx(tx).CreateLedgerEntries(ctx, forfeitureLedgerEntries(event, schedule, forfeit)); err != nil {
+				return fmt.Errorf("failed to create ledger entries: %w", err)
+			}
+		}
+
+		schedule.Status = VestingStatusForfeited
+		schedule.ForfeitedQuantity = forfeit
+		schedule.ForfeitedAt = sql.NullTime{Time: now, Valid: true}
+		schedule.ForfeitReason = sql.NullString{String: reason, Valid: reason != ""}
+
+		result.ForfeitedQuantity = forfeit
+		result.RetainedQuantity = roundToDecimal(event.SharesQuantity-forfeit, 6)
+		result.Vesting = schedule
+		return s.recordInvalidation(ctx, tx, event, result, reason, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requestctx.Logger(ctx, s.log).Infof("Reward %d invalidated: forfeited=%.6f, retained=%.6f, reason=%s",
+		rewardEventID, result.ForfeitedQuantity, result.RetainedQuantity, reason)
+
+	return result, nil
+}
+
+// recordInvalidation writes reward.reversed to the outbox and the invalidation to the
+// audit log inside the invalidation transaction. event is the reward as it was before.
+func (s *vestingService) recordInvalidation(ctx context.Context, tx *sql.Tx, event *models.RewardEvent, result *InvalidationResult, reason string, at time.Time) error {
+	data := RewardReversedData{
+		RewardEventID:     event.ID,
+		UserID:            event.UserID,
+		StockSymbol:       event.StockSymbol,
+		SharesQuantity:    event.SharesQuantity,
+		ForfeitedQuantity: result.ForfeitedQuantity,
+		RetainedQuantity:  result.RetainedQuantity,
+		Reason:            reason,
+		InvalidatedAt:     at,
+	}
+	aggregateID := strconv.FormatInt(event.ID, 10)
+	if err := enqueueOutboxEvent(ctx, s.webhookRepo.WithTx(tx), EventRewardReversed, "reward_event", aggregateID, data); err != nil {
+		return err
+	}
+	return RecordAudit(ctx, s.auditRepo.WithTx(tx), AuditRewardInvalidate, "reward_event", aggregateID, event, data)
+}
+
+// forfeitureLedgerEntries moves forfeited shares from user custody back to Stocky, at the reward price
+func forfeitureLedgerEntries(event *models.RewardEvent, schedule *models.VestingSchedule, quantity float64) []models.LedgerEntry {
+	groupID := uuid.New().String()
+	value := roundToDecimal(quantity*event.PricePerShare, 4)
+	symbol := sql.NullString{String: event.StockSymbol, Valid: true}
+
+	return []models.LedgerEntry{
+		{
+			EntryGroupID:      groupID,
+			RewardEventID:     sql.NullInt64{Int64: event.ID, Valid: true},
+			VestingScheduleID: sql.NullInt64{Int64: schedule.ID, Valid: true},
+			AccountType:       "forfeited_stock",
+			StockSymbol:       symbol,
+			DebitAmount:       value,
+			Description:       fmt.Sprintf("Forfeiture: %s x %.6f unvested shares from user %s", event.StockSymbol, quantity, event.UserID),
+		},
+		{
+			EntryGroupID:      groupID,
+			RewardEventID:     sql.NullInt64{Int64: event.ID, Valid: true},
+			VestingScheduleID: sql.NullInt64{Int64: schedule.ID, Valid: true},
+			AccountType:       "stock_inventory",
+			StockSymbol:       symbol,
+			CreditAmount:      value,
+			Description:       "Unvested shares returned from user custody",
+		},
+	}
+}
+
+// buildVestingSchedule turns a relative request into absolute vesting dates
+func buildVestingSchedule(req *VestingRequest, event *models.RewardEvent) (*models.VestingSchedule, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidVesting, fmt.Sprintf(format, args...))
+	}
+
+	start := event.RewardedAt
+	schedule := &models.VestingSchedule{
+		RewardEventID: event.ID,
+		UserID:        event.UserID,
+		StockSymbol:   event.StockSymbol,
+		ScheduleType:  req.Type,
+		TotalQuantity: event.SharesQuantity,
+		StartAt:       start,
+	}
+
+	if req.CliffDays < 0 || req.DurationDays < 0 {
+		return nil, invalid("cliff_days and duration_days cannot be negative")
+	}
+
+	switch req.Type {
+	case VestingCliff:
+		if req.CliffDays <= 0 {
+			return nil, invalid("cliff schedules require cliff_days > 0")
+		}
+		schedule.CliffAt = sql.NullTime{Time: start.AddDate(0, 0, req.CliffDays), Valid: true}
+
+	case VestingLinear:
+		if req.DurationDays <= 0 {
+			return nil, invalid("linear schedules require duration_days > 0")
+		}
+		if req.CliffDays > req.DurationDays {
+			return nil, invalid("cliff_days cannot exceed duration_days")
+		}
+		if req.CliffDays > 0 {
+			schedule.CliffAt = sql.NullTime{Time: start.AddDate(0, 0, req.CliffDays), Valid: true}
+		}
+		schedule.EndAt = sql.NullTime{Time: start.AddDate(0, 0, req.DurationDays), Valid: true}
+
+	case VestingTranche:
+		if len(req.Tranches) == 0 {
+			return nil, invalid("tranche schedules require at least one tranche")
+		}
+		totalPct := 0.0
+		allocated := 0.0
+		lastDays := -1
+		for i, t := range req.Tranches {
+			if t.AfterDays <= lastDays {
+				return nil, invalid("tranche %d: after_days must be increasing", i)
+			}
+			if t.Percent <= 0 {
+				return nil, invalid("tranche %d: percent must be positive", i)
+			}
+			lastDays = t.AfterDays
+			totalPct += t.Percent
+
+			quantity := roundToDecimal(event.SharesQuantity*t.Percent/100, 6)
+			if i == len(req.Tranches)-1 {
+				// Give rounding dust to the final tranche so the total is exact
+				quantity = roundToDecimal(event.SharesQuantity-allocated, 6)
+			}
+			allocated += quantity
+
+			schedule.Tranches = append(schedule.Tranches, models.VestingTranche{
+				VestAt:   start.AddDate(0, 0, t.AfterDays),
+				Quantity: quantity,
+			})
+		}
+		if math.Abs(totalPct-100) > 1e-6 {
+			return nil, invalid("tranche percents must sum to 100, got %.4f", totalPct)
+		}
+
+	default:
+		return nil, invalid("unknown type %q", req.Type)
+	}
+
+	return schedule, nil
+}
+
+// VestedQuantity returns how much of an active schedule has vested at the given time
+func VestedQuantity(schedule *models.VestingSchedule, at time.Time) float64 {
+	if schedule.CliffAt.Valid && at.Before(schedule.CliffAt.Time) {
+		return 0
+	}
+
+	switch schedule.ScheduleType {
+	case VestingCliff:
+		return schedule.TotalQuantity
+
+	case VestingLinear:
+		if !schedule.EndAt.Valid || !at.Before(schedule.EndAt.Time) {
+			return schedule.TotalQuantity
+		}
+		elapsed := at.Sub(schedule.StartAt).Seconds()
+		total := schedule.EndAt.Time.Sub(schedule.StartAt).Seconds()
+		if elapsed <= 0 || total <= 0 {
+			return 0
+		}
+		return roundToDecimal(schedule.TotalQuantity*elapsed/total, 6)
+
+	case VestingTranche:
+		vested := 0.0
+		for _, t := range schedule.Tranches {
+			if !at.Before(t.VestAt) {
+				vested += t.Quantity
+			}
+		}
+		return math.Min(vested, schedule.TotalQuantity)
+	}
+
+	return schedule.TotalQuantity
+}
+
+// UnvestedQuantity returns the locked quantity of a schedule at the given time.
+// Forfeited schedules lock nothing: the unvested part has already been removed.
+func UnvestedQuantity(schedule *models.VestingSchedule, at time.Time) float64 {
+	if schedule.Status != VestingStatusActive {
+		return 0
+	}
+	return math.Max(0, schedule.TotalQuantity-VestedQuantity(schedule, at))
+}
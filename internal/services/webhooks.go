@@ -0,0 +1,415 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stocky/assignment/internal/metrics"
+	"github.com/stocky/assignment/internal/models"
+	"github.com/stocky/assignment/internal/repository"
+)
+
+// Webhook event types
+const (
+	EventRewardCreated          = "reward.created"
+	EventRewardReversed         = "reward.reversed"
+	EventCorporateActionApplied = "corporate_action.applied"
+
+	// eventTypeAll subscribes to every event type
+	eventTypeAll = "*"
+)
+
+// Delivery states, mirrored by the CHECK constraint on webhook_deliveries.status
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+// Request headers sent with every webhook
+const (
+	HeaderWebhookEvent     = "X-Stocky-Event"
+	HeaderWebhookEventID   = "X-Stocky-Event-Id"
+	HeaderWebhookDelivery  = "X-Stocky-Delivery-Id"
+	HeaderWebhookTimestamp = "X-Stocky-Timestamp"
+	HeaderWebhookSignature = "X-Stocky-Signature"
+)
+
+var knownEventTypes = map[string]bool{
+	EventRewardCreated:          true,
+	EventRewardReversed:         true,
+	EventCorporateActionApplied: true,
+	eventTypeAll:                true,
+}
+
+var (
+	ErrInvalidWebhookSubscription  = errors.New("invalid webhook subscription")
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+)
+
+const (
+	// maxWebhookErrorLength bounds what is stored in webhook_deliveries.last_error
+	maxWebhookErrorLength = 500
+	// deliveryListLimit caps GET /webhooks/deliveries
+	deliveryListLimit = 200
+)
+
+// WebhookPayload is the JSON body POSTed to subscribers
+type WebhookPayload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// RewardReversedData is the payload of reward.reversed
+type RewardReversedData struct {
+	RewardEventID     int64     `json:"reward_event_id"`
+	UserID            string    `json:"user_id"`
+	StockSymbol       string    `json:"stock_symbol"`
+	SharesQuantity    float64   `json:"shares_quantity"`
+	ForfeitedQuantity float64   `json:"forfeited_quantity"`
+	RetainedQuantity  float64   `json:"retained_quantity"`
+	Reason            string    `json:"reason"`
+	InvalidatedAt     time.Time `json:"invalidated_at"`
+}
+
+type WebhookSubscriptionRequest struct {
+	Name       string   `json:"name" binding:"required"`
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required,min=1"`
+	Secret     string   `json:"secret"` // Generated when empty
+}
+
+// WebhookConfig controls the delivery worker
+type WebhookConfig struct {
+	PollInterval   time.Duration
+	RequestTimeout time.Duration
+	MaxAttempts    int           // Attempts before a delivery is dead-lettered
+	BaseBackoff    time.Duration // Delay before the first retry; doubles on every attempt
+	MaxBackoff     time.Duration
+	BatchSize      int
+}
+
+// WebhookService manages subscriptions and delivers outbox events to them
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, req *WebhookSubscriptionRequest) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error)
+	DeactivateSubscription(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, status string) ([]models.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, id int64) error
+	StartDeliveryWorker()
+	Stop()
+}
+
+type webhookService struct {
+	webhookRepo repository.WebhookRepository
+	client      *http.Client
+	config      WebhookConfig
+	log         *logrus.Logger
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository, config WebhookConfig, log *logrus.Logger) WebhookService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+		client:      &http.Client{Timeout: config.RequestTimeout},
+		config:      config,
+		log:         log,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// enqueueOutboxEvent writes an event to the outbox. Pass a transaction-bound
+// repository so the event commits or rolls back with the change it describes.
+func enqueueOutboxEvent(ctx context.Context, repo repository.WebhookRepository, eventType, aggregateType, aggregateID string, data interface{}) error {
+	eventID := uuid.New().String()
+	payload, err := json.Marshal(WebhookPayload{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	event := &models.OutboxEvent{
+		EventID:       eventID,
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+	}
+	if err := repo.CreateOutboxEvent(ctx, event); err != nil {
+		return fmt.Errorf("failed to write %s event to outbox: %w", eventType, err)
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 of "<timestamp>.<body>".
+// Receivers recompute it with their secret and compare against X-Stocky-Signature.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *webhookService) CreateSubscription(ctx context.Context, req *WebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	parsed, err := url.Parse(req.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidWebhookSubscription)
+	}
+	for _, eventType := range req.EventTypes {
+		if !knownEventTypes[eventType] {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhookSubscription, eventType)
+		}
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate signing secret: %w", err)
+		}
+		secret = "whsec_" + hex.EncodeToString(buf)
+	}
+
+	sub := &models.WebhookSubscription{
+		Name:       req.Name,
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: req.EventTypes,
+	}
+	if err := s.webhookRepo.CreateSubscription(ctx, sub); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	s.log.Infof("Webhook subscription %d created for %s (%v)", sub.ID, sub.URL, sub.EventTypes)
+	return sub, nil
+}
+
+func (s *webhookService) ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	return s.webhookRepo.ListSubscriptions(ctx)
+}
+
+func (s *webhookService) DeactivateSubscription(ctx context.Context, id int64) error {
+	found, err := s.webhookRepo.DeactivateSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate webhook subscription: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("%w: %d", ErrWebhookSubscriptionNotFound, id)
+	}
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, status string) ([]models.WebhookDelivery, error) {
+	return s.webhookRepo.ListDeliveries(ctx, status, deliveryListLimit)
+}
+
+// ReplayDelivery re-queues a delivery, typically one that was dead-lettered
+func (s *webhookService) ReplayDelivery(ctx context.Context, id int64) error {
+	found, err := s.webhookRepo.ReplayDelivery(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to replay webhook delivery: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("%w: %d", ErrWebhookDeliveryNotFound, id)
+	}
+
+	s.log.Infof("Webhook delivery %d queued for replay", id)
+	return nil
+}
+
+// StartDeliveryWorker polls the outbox and delivers due webhooks until Stop is called
+func (s *webhookService) StartDeliveryWorker() {
+	ticker := time.NewTicker(s.config.PollInterval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.runDeliveryCycle()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+
+	s.log.Infof("Webhook delivery worker started (interval: %s)", s.config.PollInterval)
+}
+
+// Stop signals the delivery worker to exit and waits for the in-flight batch to finish
+func (s *webhookService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+
+	s.log.Info("Webhook delivery worker stopped")
+}
+
+func (s *webhookService) stopping() bool {
+	select {
+	case <-s.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// runDeliveryCycle fans new outbox events out to subscriptions, then works through
+// due deliveries until the queue is drained or the worker is asked to stop
+func (s *webhookService) runDeliveryCycle() {
+	ctx := context.Background()
+
+	dispatched, err := s.webhookRepo.DispatchOutboxEvents(ctx, s.config.BatchSize)
+	if err != nil {
+		s.log.Errorf("Failed to dispatch outbox events: %v", err)
+		return
+	}
+	if dispatched > 0 {
+		s.log.Debugf("Dispatched %d outbox events", dispatched)
+	}
+
+	// Leave enough headroom that a lease never expires while its request is still running
+	lease := s.config.RequestTimeout + 30*time.Second
+
+	for !s.stopping() {
+		deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, s.config.BatchSize, lease)
+		if err != nil {
+			s.log.Errorf("Failed to claim webhook deliveries: %v", err)
+			return
+		}
+
+		for i := range deliveries {
+			s.deliver(ctx, &deliveries[i])
+		}
+
+		if len(deliveries) < s.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (s *webhookService) deliver(ctx context.Context, d *repository.PendingDelivery) {
+	if !d.SubscriptionActive {
+		s.recordFailure(ctx, d, 0, errors.New("subscription is inactive"), true)
+		return
+	}
+
+	statusCode, err := s.send(ctx, d)
+	if err != nil {
+		attempt := d.Attempts + 1
+		s.recordFailure(ctx, d, statusCode, err, attempt >= s.config.MaxAttempts)
+		return
+	}
+
+	if err := s.webhookRepo.MarkDelivered(ctx, d.ID, statusCode); err != nil {
+		s.log.Errorf("Webhook delivery %d succeeded but could not be marked delivered: %v", d.ID, err)
+		return
+	}
+	metrics.WebhookDeliveries.WithLabelValues(d.EventType, "delivered").Inc()
+}
+
+// send POSTs the signed payload; any non-2xx response counts as a failure
+func (s *webhookService) send(ctx context.Context, d *repository.PendingDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.SubscriptionURL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Stocky-Webhooks/1.0")
+	req.Header.Set(HeaderWebhookEvent, d.EventType)
+	req.Header.Set(HeaderWebhookEventID, d.EventID)
+	req.Header.Set(HeaderWebhookDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderWebhookSignature, SignWebhookPayload(d.SubscriptionSecret, timestamp, d.Payload))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	metrics.WebhookDeliveryDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a bounded amount so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (s *webhookService) recordFailure(ctx context.Context, d *repository.PendingDelivery, statusCode int, cause error, dead bool) {
+	attempt := d.Attempts + 1
+	nextAttempt := time.Now().Add(s.backoff(attempt))
+
+	errMsg := cause.Error()
+	if len(errMsg) > maxWebhookErrorLength {
+		errMsg = errMsg[:maxWebhookErrorLength]
+	}
+
+	if err := s.webhookRepo.MarkFailed(ctx, d.ID, statusCode, errMsg, nextAttempt, dead); err != nil {
+		s.log.Errorf("Failed to record webhook delivery %d failure: %v", d.ID, err)
+		return
+	}
+
+	if dead {
+		metrics.WebhookDeliveries.WithLabelValues(d.EventType, "dead").Inc()
+		s.log.Warnf("Webhook delivery %d (%s to subscription %d) dead-lettered after %d attempts: %s",
+			d.ID, d.EventType, d.SubscriptionID, attempt, errMsg)
+		return
+	}
+
+	metrics.WebhookDeliveries.WithLabelValues(d.EventType, "retry").Inc()
+	s.log.Infof("Webhook delivery %d attempt %d failed, retrying at %s: %s",
+		d.ID, attempt, nextAttempt.Format(time.RFC3339), errMsg)
+}
+
+// backoff is BaseBackoff doubled per attempt, capped at MaxBackoff, with up to 10% jitter
+// so deliveries that failed together don't retry in lockstep
+func (s *webhookService) backoff(attempt int) time.Duration {
+	delay := s.config.BaseBackoff
+	for i := 1; i < attempt && delay < s.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.config.MaxBackoff {
+		delay = s.config.MaxBackoff
+	}
+	if jitter := int64(delay / 10); jitter > 0 {
+		delay += time.Duration(mathrand.Int63n(jitter))
+	}
+	return delay
+}
@@ -0,0 +1,124 @@
+// Package tracing sets up OpenTelemetry tracing: the exporter, sampling and the
+// W3C trace-context propagator shared by the HTTP middleware, services and SQL driver.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters
+const (
+	ExporterNone   = "none"   // Spans are created but never recorded
+	ExporterStdout = "stdout" // Pretty-printed JSON on Config.Writer, for local runs and tests
+	ExporterOTLP   = "otlp"   // OTLP over HTTP to a collector
+)
+
+// Config selects where spans go and how many are kept
+type Config struct {
+	Exporter      string
+	ServiceName   string
+	SamplePercent int    // Share of new traces recorded; traces started upstream follow the caller's decision
+	OTLPEndpoint  string // e.g. http://otel-collector:4318; empty uses the OTEL_EXPORTER_OTLP_* variables
+
+	// Writer receives the stdout exporter's spans. Nil means stderr, since the
+	// JSON logs go to stdout and spans mixed into them break log parsing.
+	Writer io.Writer
+}
+
+// Setup installs the global tracer provider and propagator. The returned function
+// flushes buffered spans and must be called on shutdown.
+func Setup(ctx context.Context, cfg Config, log *logrus.Logger) (func(context.Context) error, error) {
+	// Propagate incoming trace context even when nothing is exported, so the
+	// request's trace ID still appears in logs and downstream calls
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		w := cfg.Writer
+		if w == nil {
+			w = os.Stderr
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w), stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.OTLPEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(cfg.SamplePercent)/100))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Warnf("Tracing error: %v", err)
+	}))
+
+	log.WithFields(logrus.Fields{
+		"exporter":       cfg.Exporter,
+		"sample_percent": cfg.SamplePercent,
+	}).Info("Tracing enabled")
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the named tracer from the global provider
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// End records err on the span, if any, and ends it. Call it deferred with a
+// named error result: defer func() { tracing.End(span, err) }()
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// LogFields returns the trace and span IDs of the span in ctx, for log lines.
+// It returns nil when ctx carries no valid span.
+func LogFields(ctx context.Context) logrus.Fields {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return logrus.Fields{
+		"trace_id": sc.TraceID().String(),
+		"span_id":  sc.SpanID().String(),
+	}
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestStdoutExporterWritesToConfiguredWriter(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	var spans bytes.Buffer
+	shutdown, err := Setup(context.Background(), Config{
+		Exporter:      ExporterStdout,
+		ServiceName:   "stocky-test",
+		SamplePercent: 100,
+		Writer:        &spans,
+	}, log)
+	if err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+
+	_, span := Tracer("test").Start(context.Background(), "RewardService.CreateReward")
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+
+	if !strings.Contains(spans.String(), `"Name": "RewardService.CreateReward"`) {
+		t.Errorf("span not written to the configured writer: %q", spans.String())
+	}
+}
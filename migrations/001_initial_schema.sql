@@ -10,7 +10,7 @@ CREATE TABLE IF NOT EXISTS users (
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
-CREATE INDEX idx_users_user_id ON users(user_id);
+CREATE INDEX IF NOT EXISTS idx_users_user_id ON users(user_id);
 
 -- Stock symbols reference table
 CREATE TABLE IF NOT EXISTS stocks (
@@ -23,7 +23,7 @@ CREATE TABLE IF NOT EXISTS stocks (
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
-CREATE INDEX idx_stocks_symbol ON stocks(symbol);
+CREATE INDEX IF NOT EXISTS idx_stocks_symbol ON stocks(symbol);
 
 -- Reward events - immutable log of all rewards given
 CREATE TABLE IF NOT EXISTS reward_events (
@@ -52,11 +52,11 @@ CREATE TABLE IF NOT EXISTS reward_events (
     created_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
-CREATE INDEX idx_reward_events_user_id ON reward_events(user_id);
-CREATE INDEX idx_reward_events_stock_symbol ON reward_events(stock_symbol);
-CREATE INDEX idx_reward_events_rewarded_at ON reward_events(rewarded_at);
-CREATE INDEX idx_reward_events_user_rewarded ON reward_events(user_id, rewarded_at);
-CREATE INDEX idx_reward_events_idempotency ON reward_events(idempotency_key);
+CREATE INDEX IF NOT EXISTS idx_reward_events_user_id ON reward_events(user_id);
+CREATE INDEX IF NOT EXISTS idx_reward_events_stock_symbol ON reward_events(stock_symbol);
+CREATE INDEX IF NOT EXISTS idx_reward_events_rewarded_at ON reward_events(rewarded_at);
+CREATE INDEX IF NOT EXISTS idx_reward_events_user_rewarded ON reward_events(user_id, rewarded_at);
+CREATE INDEX IF NOT EXISTS idx_reward_events_idempotency ON reward_events(idempotency_key);
 
 -- User holdings - aggregated current holdings per user per stock
 CREATE TABLE IF NOT EXISTS user_holdings (
@@ -70,8 +70,8 @@ CREATE TABLE IF NOT EXISTS user_holdings (
     UNIQUE(user_id, stock_symbol)
 );
 
-CREATE INDEX idx_user_holdings_user_id ON user_holdings(user_id);
-CREATE INDEX idx_user_holdings_stock_symbol ON user_holdings(stock_symbol);
+CREATE INDEX IF NOT EXISTS idx_user_holdings_user_id ON user_holdings(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_holdings_stock_symbol ON user_holdings(stock_symbol);
 
 -- Stock prices - hourly snapshots
 CREATE TABLE IF NOT EXISTS stock_prices (
@@ -84,9 +84,9 @@ CREATE TABLE IF NOT EXISTS stock_prices (
     UNIQUE(stock_symbol, timestamp)
 );
 
-CREATE INDEX idx_stock_prices_symbol ON stock_prices(stock_symbol);
-CREATE INDEX idx_stock_prices_timestamp ON stock_prices(timestamp);
-CREATE INDEX idx_stock_prices_symbol_timestamp ON stock_prices(stock_symbol, timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_prices_symbol ON stock_prices(stock_symbol);
+CREATE INDEX IF NOT EXISTS idx_stock_prices_timestamp ON stock_prices(timestamp);
+CREATE INDEX IF NOT EXISTS idx_stock_prices_symbol_timestamp ON stock_prices(stock_symbol, timestamp DESC);
 
 -- Ledger entries - double-entry bookkeeping
 CREATE TABLE IF NOT EXISTS ledger_entries (
@@ -109,9 +109,9 @@ CREATE TABLE IF NOT EXISTS ledger_entries (
     )
 );
 
-CREATE INDEX idx_ledger_entries_group_id ON ledger_entries(entry_group_id);
-CREATE INDEX idx_ledger_entries_reward_event ON ledger_entries(reward_event_id);
-CREATE INDEX idx_ledger_entries_account_type ON ledger_entries(account_type);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_group_id ON ledger_entries(entry_group_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_reward_event ON ledger_entries(reward_event_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_type ON ledger_entries(account_type);
 
 -- Stock events - track corporate actions (splits, mergers, delisting)
 CREATE TABLE IF NOT EXISTS stock_events (
@@ -135,9 +135,9 @@ CREATE TABLE IF NOT EXISTS stock_events (
     created_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
-CREATE INDEX idx_stock_events_symbol ON stock_events(stock_symbol);
-CREATE INDEX idx_stock_events_date ON stock_events(event_date);
-CREATE INDEX idx_stock_events_processed ON stock_events(processed);
+CREATE INDEX IF NOT EXISTS idx_stock_events_symbol ON stock_events(stock_symbol);
+CREATE INDEX IF NOT EXISTS idx_stock_events_date ON stock_events(event_date);
+CREATE INDEX IF NOT EXISTS idx_stock_events_processed ON stock_events(processed);
 
 -- Insert some default Indian stocks
 INSERT INTO stocks (symbol, company_name, exchange) VALUES
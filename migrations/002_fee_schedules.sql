@@ -0,0 +1,36 @@
+-- Versioned fee schedules used to price reward purchases
+
+CREATE TABLE IF NOT EXISTS fee_schedules (
+    id SERIAL PRIMARY KEY,
+    version INTEGER UNIQUE NOT NULL CHECK (version > 0),
+    effective_from TIMESTAMP NOT NULL, -- Applies to trades at or after this time until superseded
+
+    -- Ordered brokerage slabs by trade value, e.g.
+    -- [{"up_to": 50000, "type": "percent", "rate_bp": 5, "min": 1, "max": 20},
+    --  {"type": "flat", "flat": 20}]
+    brokerage_slabs JSONB NOT NULL,
+
+    stt_bp NUMERIC(10, 4) NOT NULL DEFAULT 0,        -- Securities Transaction Tax
+    exchange_bp NUMERIC(10, 4) NOT NULL DEFAULT 0,   -- Exchange transaction charges
+    sebi_bp NUMERIC(10, 4) NOT NULL DEFAULT 0,       -- SEBI turnover fee
+    stamp_duty_bp NUMERIC(10, 4) NOT NULL DEFAULT 0, -- Stamp duty on buy side
+    gst_pct NUMERIC(6, 3) NOT NULL DEFAULT 18,       -- GST on brokerage + exchange + SEBI charges
+
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_fee_schedules_effective_from ON fee_schedules(effective_from DESC);
+
+-- Record the schedule that priced each reward
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS stamp_duty_fee NUMERIC(18, 4) NOT NULL DEFAULT 0;
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS fee_schedule_version INTEGER;
+
+-- Initial schedule: 0.05% brokerage capped at INR 20, delivery STT, NSE charges and stamp duty
+INSERT INTO fee_schedules (
+    version, effective_from, brokerage_slabs, stt_bp, exchange_bp, sebi_bp, stamp_duty_bp, gst_pct, description
+) VALUES (
+    1, '2000-01-01', '[{"type": "percent", "rate_bp": 5, "max": 20}]',
+    10, 0.325, 0.01, 1.5, 18, 'Initial delivery fee schedule'
+)
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,44 @@
+-- Tax lots - one acquisition lot per reward, consumed FIFO on disposal
+
+CREATE TABLE IF NOT EXISTS tax_lots (
+    id SERIAL PRIMARY KEY,
+    reward_event_id INTEGER UNIQUE NOT NULL REFERENCES reward_events(id),
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    acquired_at TIMESTAMP NOT NULL, -- Reward date, starts the holding period
+    quantity NUMERIC(18, 6) NOT NULL CHECK (quantity > 0),
+    remaining_quantity NUMERIC(18, 6) NOT NULL CHECK (remaining_quantity >= 0),
+    cost_per_share NUMERIC(18, 4) NOT NULL, -- FMV on reward date, taxed as perquisite and used as cost basis
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CHECK (remaining_quantity <= quantity)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tax_lots_user_symbol ON tax_lots(user_id, stock_symbol, acquired_at);
+CREATE INDEX IF NOT EXISTS idx_tax_lots_user_acquired ON tax_lots(user_id, acquired_at);
+
+-- Lot disposals - the portion of a lot consumed by a sale or transfer
+CREATE TABLE IF NOT EXISTS lot_disposals (
+    id SERIAL PRIMARY KEY,
+    tax_lot_id INTEGER NOT NULL REFERENCES tax_lots(id),
+    disposal_id INTEGER, -- Parent disposal record
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    quantity NUMERIC(18, 6) NOT NULL CHECK (quantity > 0),
+    acquired_at TIMESTAMP NOT NULL,
+    disposed_at TIMESTAMP NOT NULL,
+    cost_per_share NUMERIC(18, 4) NOT NULL,
+    proceeds_per_share NUMERIC(18, 4) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_lot_disposals_user_disposed ON lot_disposals(user_id, disposed_at);
+CREATE INDEX IF NOT EXISTS idx_lot_disposals_lot ON lot_disposals(tax_lot_id);
+
+-- Backfill lots for rewards issued before tax lots existed
+INSERT INTO tax_lots (
+    reward_event_id, user_id, stock_symbol, acquired_at, quantity, remaining_quantity, cost_per_share
+)
+SELECT id, user_id, stock_symbol, rewarded_at, shares_quantity, shares_quantity, price_per_share
+FROM reward_events
+ON CONFLICT (reward_event_id) DO NOTHING;
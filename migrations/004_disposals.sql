@@ -0,0 +1,35 @@
+-- Disposals - user-initiated sales and transfers of rewarded shares
+
+CREATE TABLE IF NOT EXISTS disposals (
+    id SERIAL PRIMARY KEY,
+    idempotency_key VARCHAR(255) UNIQUE NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    disposal_type VARCHAR(20) NOT NULL CHECK (disposal_type IN ('sell', 'transfer_out')),
+    quantity NUMERIC(18, 6) NOT NULL CHECK (quantity > 0),
+    price_per_share NUMERIC(18, 4) NOT NULL, -- Sale price, or FMV at transfer time
+    gross_proceeds NUMERIC(18, 4) NOT NULL DEFAULT 0,
+
+    -- Sell-side fees, deducted from the user's proceeds
+    brokerage_fee NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    stt_fee NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    gst_fee NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    exchange_fee NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    sebi_fee NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    total_fees NUMERIC(18, 4) NOT NULL DEFAULT 0,
+
+    net_proceeds NUMERIC(18, 4) NOT NULL DEFAULT 0, -- gross_proceeds - total_fees
+    demat_account VARCHAR(50), -- Destination DP/client ID for transfers
+    fee_schedule_version INTEGER,
+
+    disposed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_disposals_user_id ON disposals(user_id, disposed_at);
+
+ALTER TABLE lot_disposals
+    ADD CONSTRAINT fk_lot_disposals_disposal FOREIGN KEY (disposal_id) REFERENCES disposals(id);
+
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS disposal_id INTEGER REFERENCES disposals(id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_disposal ON ledger_entries(disposal_id);
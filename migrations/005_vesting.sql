@@ -0,0 +1,30 @@
+-- Vesting schedules - optional lock-in / vesting attached to a reward
+
+CREATE TABLE IF NOT EXISTS vesting_schedules (
+    id SERIAL PRIMARY KEY,
+    reward_event_id INTEGER UNIQUE NOT NULL REFERENCES reward_events(id),
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    schedule_type VARCHAR(20) NOT NULL CHECK (schedule_type IN ('cliff', 'linear', 'tranche')),
+    total_quantity NUMERIC(18, 6) NOT NULL CHECK (total_quantity > 0),
+
+    start_at TIMESTAMP NOT NULL, -- Reward date
+    cliff_at TIMESTAMP,          -- Nothing vests before this (cliff, linear)
+    end_at TIMESTAMP,            -- Fully vested at this time (linear)
+    tranches JSONB,              -- [{"vest_at": "...", "quantity": 1.5}, ...] (tranche)
+
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'forfeited')),
+    forfeited_quantity NUMERIC(18, 6) NOT NULL DEFAULT 0,
+    forfeited_at TIMESTAMP,
+    forfeit_reason TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_vesting_schedules_user_symbol ON vesting_schedules(user_id, stock_symbol);
+
+-- Rewards can be invalidated later (e.g. a fraudulent referral); the event itself stays immutable
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS invalidated_at TIMESTAMP;
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS invalidation_reason TEXT;
+
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS vesting_schedule_id INTEGER REFERENCES vesting_schedules(id);
@@ -0,0 +1,121 @@
+-- Stock price storage - monthly partitions, O(1) latest-price table and daily OHLC rollups
+
+-- Move the unpartitioned table aside, freeing its constraint and index names
+ALTER TABLE stock_prices RENAME TO stock_prices_legacy;
+ALTER TABLE stock_prices_legacy RENAME CONSTRAINT stock_prices_pkey TO stock_prices_legacy_pkey;
+ALTER TABLE stock_prices_legacy RENAME CONSTRAINT stock_prices_stock_symbol_timestamp_key TO stock_prices_legacy_stock_symbol_timestamp_key;
+DROP INDEX IF EXISTS idx_stock_prices_symbol;
+DROP INDEX IF EXISTS idx_stock_prices_timestamp;
+DROP INDEX IF EXISTS idx_stock_prices_symbol_timestamp;
+
+-- Keep the id sequence; it outlives the legacy table
+ALTER SEQUENCE stock_prices_id_seq OWNED BY NONE;
+ALTER SEQUENCE stock_prices_id_seq AS BIGINT;
+
+-- Partitioned by month on timestamp. The primary key must include the partition
+-- key, so (stock_symbol, timestamp) replaces the old surrogate key; ids stay unique
+-- through the shared sequence.
+CREATE TABLE stock_prices (
+    id BIGINT NOT NULL DEFAULT nextval('stock_prices_id_seq'),
+    stock_symbol VARCHAR(20) NOT NULL,
+    price NUMERIC(18, 4) NOT NULL CHECK (price > 0),
+    timestamp TIMESTAMP NOT NULL,
+    source VARCHAR(50) NOT NULL DEFAULT 'mock', -- 'mock', 'nse', 'bse', etc.
+
+    PRIMARY KEY (stock_symbol, timestamp)
+) PARTITION BY RANGE (timestamp);
+
+ALTER SEQUENCE stock_prices_id_seq OWNED BY stock_prices.id;
+
+CREATE INDEX IF NOT EXISTS idx_stock_prices_timestamp ON stock_prices(timestamp);
+
+-- Catches rows outside every monthly partition; the retention job keeps partitions
+-- created ahead of time so this should stay empty
+CREATE TABLE IF NOT EXISTS stock_prices_default PARTITION OF stock_prices DEFAULT;
+
+-- Creates any missing monthly partitions covering [from_ts, to_ts]; returns how many were created
+CREATE OR REPLACE FUNCTION ensure_stock_price_partitions(from_ts TIMESTAMP, to_ts TIMESTAMP)
+RETURNS INTEGER AS $$
+DECLARE
+    month_start TIMESTAMP := date_trunc('month', from_ts);
+    partition_name TEXT;
+    created INTEGER := 0;
+BEGIN
+    WHILE month_start <= to_ts LOOP
+        partition_name := 'stock_prices_' || to_char(month_start, '"y"YYYY"m"MM');
+        IF to_regclass(partition_name) IS NULL THEN
+            EXECUTE format(
+                'CREATE TABLE %I PARTITION OF stock_prices FOR VALUES FROM (%L) TO (%L)',
+                partition_name, month_start, month_start + INTERVAL '1 month'
+            );
+            created := created + 1;
+        END IF;
+        month_start := month_start + INTERVAL '1 month';
+    END LOOP;
+    RETURN created;
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT ensure_stock_price_partitions(
+    LEAST(COALESCE((SELECT MIN(timestamp) FROM stock_prices_legacy), NOW()::TIMESTAMP), NOW()::TIMESTAMP),
+    (NOW() + INTERVAL '3 months')::TIMESTAMP
+);
+
+-- Latest price per symbol, kept current by a trigger on stock_prices
+CREATE TABLE IF NOT EXISTS latest_stock_prices (
+    stock_symbol VARCHAR(20) PRIMARY KEY,
+    price_id BIGINT NOT NULL,
+    price NUMERIC(18, 4) NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION refresh_latest_stock_price() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO latest_stock_prices (stock_symbol, price_id, price, timestamp, source, updated_at)
+    VALUES (NEW.stock_symbol, NEW.id, NEW.price, NEW.timestamp, NEW.source, NOW())
+    ON CONFLICT (stock_symbol) DO UPDATE
+    SET price_id = EXCLUDED.price_id,
+        price = EXCLUDED.price,
+        timestamp = EXCLUDED.timestamp,
+        source = EXCLUDED.source,
+        updated_at = NOW()
+    -- Backfilled older ticks must not replace the latest one
+    WHERE latest_stock_prices.timestamp <= EXCLUDED.timestamp;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Daily OHLC rollups of ticks older than the retention window
+CREATE TABLE IF NOT EXISTS stock_prices_daily (
+    stock_symbol VARCHAR(20) NOT NULL,
+    trade_date DATE NOT NULL,
+    open NUMERIC(18, 4) NOT NULL,
+    high NUMERIC(18, 4) NOT NULL,
+    low NUMERIC(18, 4) NOT NULL,
+    close NUMERIC(18, 4) NOT NULL,
+    tick_count INTEGER NOT NULL,
+    open_at TIMESTAMP NOT NULL,  -- Timestamps of the open and close ticks, so late
+    close_at TIMESTAMP NOT NULL, -- ticks for an already rolled-up day merge correctly
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (stock_symbol, trade_date)
+);
+
+-- Copy history across before the trigger exists, then seed latest prices in one pass
+INSERT INTO stock_prices (id, stock_symbol, price, timestamp, source)
+SELECT id, stock_symbol, price, timestamp, source
+FROM stock_prices_legacy;
+
+DROP TABLE stock_prices_legacy;
+
+INSERT INTO latest_stock_prices (stock_symbol, price_id, price, timestamp, source)
+SELECT DISTINCT ON (stock_symbol) stock_symbol, id, price, timestamp, source
+FROM stock_prices
+ORDER BY stock_symbol, timestamp DESC;
+
+CREATE TRIGGER trg_stock_prices_latest
+    AFTER INSERT OR UPDATE ON stock_prices
+    FOR EACH ROW EXECUTE FUNCTION refresh_latest_stock_price();
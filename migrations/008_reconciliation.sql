@@ -0,0 +1,27 @@
+-- Holdings reconciliation - run history and an audit trail of repaired holdings
+
+CREATE TABLE IF NOT EXISTS reconciliation_runs (
+    id UUID PRIMARY KEY,
+    scope VARCHAR(100), -- user_id when the run was limited to one user, NULL for all users
+    repair BOOLEAN NOT NULL,
+    holdings_checked INTEGER NOT NULL DEFAULT 0,
+    mismatches INTEGER NOT NULL DEFAULT 0,
+    repaired INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS holding_reconciliation_audit (
+    id SERIAL PRIMARY KEY,
+    run_id UUID NOT NULL REFERENCES reconciliation_runs(id),
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    previous_shares NUMERIC(18, 6), -- NULL when the holding row was missing
+    previous_average_price NUMERIC(18, 4),
+    repaired_shares NUMERIC(18, 6) NOT NULL,
+    repaired_average_price NUMERIC(18, 4) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_holding_reconciliation_audit_run ON holding_reconciliation_audit(run_id);
+CREATE INDEX IF NOT EXISTS idx_holding_reconciliation_audit_user ON holding_reconciliation_audit(user_id, stock_symbol);
@@ -0,0 +1,24 @@
+-- Corporate actions - per-holding adjustments made when a stock_events row is applied.
+-- Reconciliation replays these alongside rewards, disposals and forfeitures.
+
+CREATE TABLE IF NOT EXISTS corporate_action_adjustments (
+    id SERIAL PRIMARY KEY,
+    stock_event_id INTEGER NOT NULL REFERENCES stock_events(id),
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    -- 'split': shares multiplied by share_factor, average price divided by it (splits and bonus issues)
+    -- 'merger_out': all shares of the merged stock removed
+    -- 'merger_in': converted shares added to the surviving stock at price_per_share
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('split', 'merger_out', 'merger_in')),
+    share_factor NUMERIC(18, 6) NOT NULL DEFAULT 1,
+    quantity NUMERIC(18, 6) NOT NULL DEFAULT 0,
+    price_per_share NUMERIC(18, 4) NOT NULL DEFAULT 0,
+    previous_shares NUMERIC(18, 6) NOT NULL,
+    previous_average_price NUMERIC(18, 4) NOT NULL,
+    new_shares NUMERIC(18, 6) NOT NULL,
+    new_average_price NUMERIC(18, 4) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_corporate_action_adjustments_event ON corporate_action_adjustments(stock_event_id);
+CREATE INDEX IF NOT EXISTS idx_corporate_action_adjustments_user ON corporate_action_adjustments(user_id, stock_symbol);
@@ -0,0 +1,20 @@
+-- Reward budgets - spend is measured on reward_events.total_cost by created_at, so a
+-- backdated rewarded_at cannot move spend into another day. Overrides that let a
+-- reward exceed a budget are recorded here.
+
+CREATE INDEX IF NOT EXISTS idx_reward_events_created_at ON reward_events(created_at);
+CREATE INDEX IF NOT EXISTS idx_reward_events_user_created ON reward_events(user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS reward_budget_overrides (
+    id SERIAL PRIMARY KEY,
+    reward_event_id INTEGER NOT NULL UNIQUE REFERENCES reward_events(id),
+    user_id VARCHAR(100) NOT NULL,
+    approved_by VARCHAR(100) NOT NULL,
+    justification TEXT NOT NULL,
+    -- Every limit the reward exceeded: [{"limit": "user_daily", "limit_inr": ..., "spent_inr": ..., "requested_inr": ...}]
+    breaches JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reward_budget_overrides_user ON reward_budget_overrides(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_reward_budget_overrides_created ON reward_budget_overrides(created_at);
@@ -0,0 +1,48 @@
+-- Risk checks on reward creation. Allowed rewards record the decision and the signals
+-- that were raised. Held and denied rewards are not written to reward_events: they wait
+-- in reward_risk_reviews, and approving a held one creates its reward_events row.
+
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS device_id VARCHAR(255);
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45);
+-- 'allow', or 'hold' for a reward issued after review
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS risk_decision VARCHAR(10) NOT NULL DEFAULT 'allow'
+    CHECK (risk_decision IN ('allow', 'hold'));
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS risk_signals JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_reward_events_device_created ON reward_events(device_id, created_at) WHERE device_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_reward_events_ip_created ON reward_events(ip_address, created_at) WHERE ip_address IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS reward_risk_reviews (
+    id SERIAL PRIMARY KEY,
+    idempotency_key VARCHAR(255) UNIQUE NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    stock_symbol VARCHAR(20) NOT NULL,
+    device_id VARCHAR(255),
+    ip_address VARCHAR(45),
+    metadata JSONB,
+    request JSONB NOT NULL, -- The reward request, replayed when the review is approved
+    decision VARCHAR(10) NOT NULL CHECK (decision IN ('hold', 'deny')),
+    signals JSONB NOT NULL DEFAULT '[]',
+    -- 'pending' (held), 'approved', 'rejected' (held, then rejected) or 'denied' (never reviewable)
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'approved', 'rejected', 'denied')),
+    reward_event_id INTEGER REFERENCES reward_events(id),
+    reviewed_by VARCHAR(100),
+    review_note TEXT,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reward_risk_reviews_status ON reward_risk_reviews(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_reward_risk_reviews_user ON reward_risk_reviews(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_reward_risk_reviews_device ON reward_risk_reviews(device_id, created_at) WHERE device_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_reward_risk_reviews_ip ON reward_risk_reviews(ip_address, created_at) WHERE ip_address IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS risk_blocklist (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('user_id', 'device_id', 'ip_address')),
+    value VARCHAR(255) NOT NULL, -- ip_address entries may be a CIDR range
+    reason TEXT NOT NULL,
+    created_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, value)
+);
@@ -0,0 +1,97 @@
+-- Instruments and listings. The ISIN identifies an instrument; stocks.symbol stays the
+-- internal key that holdings, rewards and lots refer to, so it never changes when an
+-- exchange renames its ticker. stock_listings maps each exchange's ticker to the ISIN,
+-- and prices are stored per listing. stocks.exchange is the primary listing, whose
+-- price values holdings.
+
+ALTER TABLE stocks ADD COLUMN IF NOT EXISTS isin VARCHAR(12) UNIQUE
+    CHECK (isin ~ '^[A-Z]{2}[A-Z0-9]{9}[0-9]$');
+
+UPDATE stocks s
+SET isin = v.isin, updated_at = NOW()
+FROM (VALUES
+    ('RELIANCE', 'INE002A01018'),
+    ('TCS', 'INE467B01029'),
+    ('INFY', 'INE009A01021'),
+    ('HDFCBANK', 'INE040A01034'),
+    ('ICICIBANK', 'INE090A01021'),
+    ('HINDUNILVR', 'INE030A01027'),
+    ('ITC', 'INE154A01025'),
+    ('BHARTIARTL', 'INE397D01024'),
+    ('KOTAKBANK', 'INE237A01028'),
+    ('WIPRO', 'INE075A01022')
+) AS v(symbol, isin)
+WHERE s.symbol = v.symbol AND s.isin IS NULL;
+
+CREATE TABLE IF NOT EXISTS stock_listings (
+    id SERIAL PRIMARY KEY,
+    isin VARCHAR(12) NOT NULL REFERENCES stocks(isin) ON UPDATE CASCADE,
+    exchange VARCHAR(10) NOT NULL CHECK (exchange IN ('NSE', 'BSE')),
+    symbol VARCHAR(20) NOT NULL, -- The exchange's ticker; a rename updates it in place
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    UNIQUE (exchange, symbol),
+    UNIQUE (isin, exchange)
+);
+
+-- The seeded stocks trade under the same ticker on both exchanges
+INSERT INTO stock_listings (isin, exchange, symbol)
+SELECT s.isin, e.exchange, s.symbol
+FROM stocks s
+CROSS JOIN (VALUES ('NSE'), ('BSE')) AS e(exchange)
+WHERE s.isin IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- Prices per listing: (stock_symbol, exchange) names the listing. Existing prices
+-- belong to each stock's primary listing.
+ALTER TABLE latest_stock_prices ADD COLUMN IF NOT EXISTS exchange VARCHAR(10) NOT NULL DEFAULT 'NSE';
+UPDATE latest_stock_prices lp
+SET exchange = s.exchange
+FROM stocks s
+WHERE s.symbol = lp.stock_symbol AND s.exchange <> lp.exchange;
+ALTER TABLE latest_stock_prices DROP CONSTRAINT latest_stock_prices_pkey;
+ALTER TABLE latest_stock_prices ADD PRIMARY KEY (stock_symbol, exchange);
+
+CREATE OR REPLACE FUNCTION refresh_latest_stock_price() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO latest_stock_prices (stock_symbol, exchange, price_id, price, timestamp, source, updated_at)
+    VALUES (NEW.stock_symbol, NEW.exchange, NEW.id, NEW.price, NEW.timestamp, NEW.source, NOW())
+    ON CONFLICT (stock_symbol, exchange) DO UPDATE
+    SET price_id = EXCLUDED.price_id,
+        price = EXCLUDED.price,
+        timestamp = EXCLUDED.timestamp,
+        source = EXCLUDED.source,
+        updated_at = NOW()
+    -- Backfilled older ticks must not replace the latest one
+    WHERE latest_stock_prices.timestamp <= EXCLUDED.timestamp;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE stock_prices ADD COLUMN IF NOT EXISTS exchange VARCHAR(10) NOT NULL DEFAULT 'NSE';
+UPDATE stock_prices sp
+SET exchange = s.exchange
+FROM stocks s
+WHERE s.symbol = sp.stock_symbol AND s.exchange <> sp.exchange;
+ALTER TABLE stock_prices DROP CONSTRAINT stock_prices_pkey;
+ALTER TABLE stock_prices ADD PRIMARY KEY (stock_symbol, exchange, timestamp);
+
+ALTER TABLE stock_prices_daily ADD COLUMN IF NOT EXISTS exchange VARCHAR(10) NOT NULL DEFAULT 'NSE';
+UPDATE stock_prices_daily d
+SET exchange = s.exchange
+FROM stocks s
+WHERE s.symbol = d.stock_symbol AND s.exchange <> d.exchange;
+ALTER TABLE stock_prices_daily DROP CONSTRAINT stock_prices_daily_pkey;
+ALTER TABLE stock_prices_daily ADD PRIMARY KEY (stock_symbol, exchange, trade_date);
+
+-- The instrument and the listing a reward was priced on
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS isin VARCHAR(12);
+ALTER TABLE reward_events ADD COLUMN IF NOT EXISTS exchange VARCHAR(10);
+UPDATE reward_events re
+SET isin = s.isin, exchange = s.exchange
+FROM stocks s
+WHERE s.symbol = re.stock_symbol AND re.exchange IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reward_events_isin ON reward_events(isin) WHERE isin IS NOT NULL;
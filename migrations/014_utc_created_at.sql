@@ -0,0 +1,7 @@
+-- Reward budgets, risk velocity checks and the ledger repost compare created_at with
+-- UTC times computed in Go. A bare NOW() stores the wall clock of the session time
+-- zone, so day and month boundaries would move with server settings. Store UTC
+-- explicitly. Existing rows were written under the default UTC session time zone.
+
+ALTER TABLE reward_events ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
+ALTER TABLE reward_risk_reviews ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
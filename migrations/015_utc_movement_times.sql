@@ -0,0 +1,8 @@
+-- Reconciliation replays rewards, disposals, forfeitures and corporate action
+-- adjustments in the order of their timestamps. 014 moved reward_events to UTC, so
+-- the other movements must be stored in UTC too, or a non-UTC session time zone
+-- would interleave them out of order. forfeited_at is converted to UTC by the repository.
+
+ALTER TABLE disposals ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
+ALTER TABLE vesting_schedules ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
+ALTER TABLE corporate_action_adjustments ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
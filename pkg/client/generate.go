@@ -0,0 +1,7 @@
+// Package client is a typed Go client for the Stocky HTTP API, generated from the
+// OpenAPI spec the server publishes at /openapi.json. Regenerate it with
+// go generate ./pkg/client after changing a route or a request or response type.
+package client
+
+//go:generate go run ../../cmd/openapi -o ../../api/openapi.json
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.3.0 -config oapi-codegen.yaml ../../api/openapi.json
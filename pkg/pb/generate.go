@@ -0,0 +1,9 @@
+// Package pb holds the Go code generated from the protobuf definitions in
+// api/proto. Regenerate it with go generate ./pkg/pb after editing a .proto file;
+// that needs the plugins the checked-in code was generated with:
+//
+//	go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.34.2
+//	go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.4.0
+package pb
+
+//go:generate go run github.com/bufbuild/buf/cmd/buf@v1.34.0 generate ../../api/proto --template ../../api/proto/buf.gen.yaml --output ../..
@@ -0,0 +1,2267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: stocky/v1/rewards.proto
+
+package stockyv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RewardStatus int32
+
+const (
+	RewardStatus_REWARD_STATUS_UNSPECIFIED RewardStatus = 0
+	// The reward was created, or already existed for the idempotency key
+	RewardStatus_REWARD_STATUS_ISSUED RewardStatus = 1
+	// The risk checks held the reward for review; approving the review issues it
+	RewardStatus_REWARD_STATUS_HELD RewardStatus = 2
+	// The reward was not created; see the result's error
+	RewardStatus_REWARD_STATUS_FAILED RewardStatus = 3
+)
+
+// Enum value maps for RewardStatus.
+var (
+	RewardStatus_name = map[int32]string{
+		0: "REWARD_STATUS_UNSPECIFIED",
+		1: "REWARD_STATUS_ISSUED",
+		2: "REWARD_STATUS_HELD",
+		3: "REWARD_STATUS_FAILED",
+	}
+	RewardStatus_value = map[string]int32{
+		"REWARD_STATUS_UNSPECIFIED": 0,
+		"REWARD_STATUS_ISSUED":      1,
+		"REWARD_STATUS_HELD":        2,
+		"REWARD_STATUS_FAILED":      3,
+	}
+)
+
+func (x RewardStatus) Enum() *RewardStatus {
+	p := new(RewardStatus)
+	*p = x
+	return p
+}
+
+func (x RewardStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RewardStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocky_v1_rewards_proto_enumTypes[0].Descriptor()
+}
+
+func (RewardStatus) Type() protoreflect.EnumType {
+	return &file_stocky_v1_rewards_proto_enumTypes[0]
+}
+
+func (x RewardStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RewardStatus.Descriptor instead.
+func (RewardStatus) EnumDescriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdempotencyKey string `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Stocky's symbol, or exchange:symbol such as BSE:RELIANCE to price on that
+	// listing. Either this or isin is required.
+	StockSymbol    string  `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	SharesQuantity float64 `protobuf:"fixed64,4,opt,name=shares_quantity,json=sharesQuantity,proto3" json:"shares_quantity,omitempty"`
+	Reason         string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Metadata       string  `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Defaults to the time of the call
+	RewardedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=rewarded_at,json=rewardedAt,proto3" json:"rewarded_at,omitempty"`
+	// Where the reward was earned, for the risk checks
+	DeviceId  string `protobuf:"bytes,8,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IpAddress string `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Optional lock-in; shares can't be sold or transferred until vested
+	Vesting *VestingRequest `protobuf:"bytes,10,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// Names the stock on its primary listing; with stock_symbol, both must name
+	// the same stock
+	Isin string `protobuf:"bytes,11,opt,name=isin,proto3" json:"isin,omitempty"`
+}
+
+func (x *CreateRewardRequest) Reset() {
+	*x = CreateRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRewardRequest) ProtoMessage() {}
+
+func (x *CreateRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRewardRequest.ProtoReflect.Descriptor instead.
+func (*CreateRewardRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRewardRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetSharesQuantity() float64 {
+	if x != nil {
+		return x.SharesQuantity
+	}
+	return 0
+}
+
+func (x *CreateRewardRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetRewardedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RewardedAt
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetVesting() *VestingRequest {
+	if x != nil {
+		return x.Vesting
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetIsin() string {
+	if x != nil {
+		return x.Isin
+	}
+	return ""
+}
+
+type VestingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cliff, linear or tranche
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// cliff: lock-in length; linear: optional cliff
+	CliffDays int32 `protobuf:"varint,2,opt,name=cliff_days,json=cliffDays,proto3" json:"cliff_days,omitempty"`
+	// linear: days until fully vested
+	DurationDays int32 `protobuf:"varint,3,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	// tranche: must sum to 100 percent
+	Tranches []*VestingTrancheRequest `protobuf:"bytes,4,rep,name=tranches,proto3" json:"tranches,omitempty"`
+}
+
+func (x *VestingRequest) Reset() {
+	*x = VestingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingRequest) ProtoMessage() {}
+
+func (x *VestingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingRequest.ProtoReflect.Descriptor instead.
+func (*VestingRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VestingRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VestingRequest) GetCliffDays() int32 {
+	if x != nil {
+		return x.CliffDays
+	}
+	return 0
+}
+
+func (x *VestingRequest) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *VestingRequest) GetTranches() []*VestingTrancheRequest {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+type VestingTrancheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AfterDays int32   `protobuf:"varint,1,opt,name=after_days,json=afterDays,proto3" json:"after_days,omitempty"`
+	Percent   float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *VestingTrancheRequest) Reset() {
+	*x = VestingTrancheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingTrancheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingTrancheRequest) ProtoMessage() {}
+
+func (x *VestingTrancheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingTrancheRequest.ProtoReflect.Descriptor instead.
+func (*VestingTrancheRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VestingTrancheRequest) GetAfterDays() int32 {
+	if x != nil {
+		return x.AfterDays
+	}
+	return 0
+}
+
+func (x *VestingTrancheRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type CreateRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RewardStatus `protobuf:"varint,1,opt,name=status,proto3,enum=stocky.v1.RewardStatus" json:"status,omitempty"`
+	// Set when ISSUED
+	Reward *RewardEvent `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	// Set when HELD
+	Review *RewardReview `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *CreateRewardResponse) Reset() {
+	*x = CreateRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRewardResponse) ProtoMessage() {}
+
+func (x *CreateRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRewardResponse.ProtoReflect.Descriptor instead.
+func (*CreateRewardResponse) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRewardResponse) GetStatus() RewardStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RewardStatus_REWARD_STATUS_UNSPECIFIED
+}
+
+func (x *CreateRewardResponse) GetReward() *RewardEvent {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *CreateRewardResponse) GetReview() *RewardReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type BatchCreateRewardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards []*CreateRewardRequest `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *BatchCreateRewardsRequest) Reset() {
+	*x = BatchCreateRewardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRewardsRequest) ProtoMessage() {}
+
+func (x *BatchCreateRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRewardsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchCreateRewardsRequest) GetRewards() []*CreateRewardRequest {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type BatchCreateRewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchRewardResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchCreateRewardsResponse) Reset() {
+	*x = BatchCreateRewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRewardsResponse) ProtoMessage() {}
+
+func (x *BatchCreateRewardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRewardsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchCreateRewardsResponse) GetResults() []*BatchRewardResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchRewardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RewardStatus  `protobuf:"varint,1,opt,name=status,proto3,enum=stocky.v1.RewardStatus" json:"status,omitempty"`
+	Reward *RewardEvent  `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	Review *RewardReview `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	// Set when FAILED: the google.rpc.Code CreateReward would have returned,
+	// e.g. 9 (FAILED_PRECONDITION) for a budget breach, and its message
+	ErrorCode    int32  `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *BatchRewardResult) Reset() {
+	*x = BatchRewardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRewardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRewardResult) ProtoMessage() {}
+
+func (x *BatchRewardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRewardResult.ProtoReflect.Descriptor instead.
+func (*BatchRewardResult) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchRewardResult) GetStatus() RewardStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RewardStatus_REWARD_STATUS_UNSPECIFIED
+}
+
+func (x *BatchRewardResult) GetReward() *RewardEvent {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *BatchRewardResult) GetReview() *RewardReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *BatchRewardResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BatchRewardResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RewardEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StockSymbol    string                 `protobuf:"bytes,4,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	SharesQuantity float64                `protobuf:"fixed64,5,opt,name=shares_quantity,json=sharesQuantity,proto3" json:"shares_quantity,omitempty"`
+	PricePerShare  float64                `protobuf:"fixed64,6,opt,name=price_per_share,json=pricePerShare,proto3" json:"price_per_share,omitempty"`
+	TotalValue     float64                `protobuf:"fixed64,7,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	BrokerageFee   float64                `protobuf:"fixed64,8,opt,name=brokerage_fee,json=brokerageFee,proto3" json:"brokerage_fee,omitempty"`
+	SttFee         float64                `protobuf:"fixed64,9,opt,name=stt_fee,json=sttFee,proto3" json:"stt_fee,omitempty"`
+	GstFee         float64                `protobuf:"fixed64,10,opt,name=gst_fee,json=gstFee,proto3" json:"gst_fee,omitempty"`
+	ExchangeFee    float64                `protobuf:"fixed64,11,opt,name=exchange_fee,json=exchangeFee,proto3" json:"exchange_fee,omitempty"`
+	SebiFee        float64                `protobuf:"fixed64,12,opt,name=sebi_fee,json=sebiFee,proto3" json:"sebi_fee,omitempty"`
+	StampDutyFee   float64                `protobuf:"fixed64,13,opt,name=stamp_duty_fee,json=stampDutyFee,proto3" json:"stamp_duty_fee,omitempty"`
+	TotalFees      float64                `protobuf:"fixed64,14,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	TotalCost      float64                `protobuf:"fixed64,15,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	Reason         string                 `protobuf:"bytes,16,opt,name=reason,proto3" json:"reason,omitempty"`
+	Metadata       string                 `protobuf:"bytes,17,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	RewardedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=rewarded_at,json=rewardedAt,proto3" json:"rewarded_at,omitempty"`
+	RiskDecision   string                 `protobuf:"bytes,19,opt,name=risk_decision,json=riskDecision,proto3" json:"risk_decision,omitempty"`
+	RiskSignals    []*RiskSignal          `protobuf:"bytes,20,rep,name=risk_signals,json=riskSignals,proto3" json:"risk_signals,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set when the reward has a lock-in
+	Vesting *VestingSchedule `protobuf:"bytes,22,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	Isin    string           `protobuf:"bytes,23,opt,name=isin,proto3" json:"isin,omitempty"`
+	// The listing the reward was priced on, NSE or BSE
+	Exchange string `protobuf:"bytes,24,opt,name=exchange,proto3" json:"exchange,omitempty"`
+}
+
+func (x *RewardEvent) Reset() {
+	*x = RewardEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardEvent) ProtoMessage() {}
+
+func (x *RewardEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardEvent.ProtoReflect.Descriptor instead.
+func (*RewardEvent) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RewardEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetSharesQuantity() float64 {
+	if x != nil {
+		return x.SharesQuantity
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetPricePerShare() float64 {
+	if x != nil {
+		return x.PricePerShare
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetBrokerageFee() float64 {
+	if x != nil {
+		return x.BrokerageFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetSttFee() float64 {
+	if x != nil {
+		return x.SttFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetGstFee() float64 {
+	if x != nil {
+		return x.GstFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetExchangeFee() float64 {
+	if x != nil {
+		return x.ExchangeFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetSebiFee() float64 {
+	if x != nil {
+		return x.SebiFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetStampDutyFee() float64 {
+	if x != nil {
+		return x.StampDutyFee
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetTotalFees() float64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *RewardEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetRewardedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RewardedAt
+	}
+	return nil
+}
+
+func (x *RewardEvent) GetRiskDecision() string {
+	if x != nil {
+		return x.RiskDecision
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetRiskSignals() []*RiskSignal {
+	if x != nil {
+		return x.RiskSignals
+	}
+	return nil
+}
+
+func (x *RewardEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RewardEvent) GetVesting() *VestingSchedule {
+	if x != nil {
+		return x.Vesting
+	}
+	return nil
+}
+
+func (x *RewardEvent) GetIsin() string {
+	if x != nil {
+		return x.Isin
+	}
+	return ""
+}
+
+func (x *RewardEvent) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type VestingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cliff, linear or tranche
+	ScheduleType  string                 `protobuf:"bytes,1,opt,name=schedule_type,json=scheduleType,proto3" json:"schedule_type,omitempty"`
+	TotalQuantity float64                `protobuf:"fixed64,2,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	CliffAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=cliff_at,json=cliffAt,proto3" json:"cliff_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Tranches      []*VestingTranche      `protobuf:"bytes,6,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *VestingSchedule) Reset() {
+	*x = VestingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingSchedule) ProtoMessage() {}
+
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VestingSchedule) GetScheduleType() string {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetTotalQuantity() float64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *VestingSchedule) GetCliffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CliffAt
+	}
+	return nil
+}
+
+func (x *VestingSchedule) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *VestingSchedule) GetTranches() []*VestingTranche {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (x *VestingSchedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type VestingTranche struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VestAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=vest_at,json=vestAt,proto3" json:"vest_at,omitempty"`
+	Quantity float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *VestingTranche) Reset() {
+	*x = VestingTranche{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingTranche) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingTranche) ProtoMessage() {}
+
+func (x *VestingTranche) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingTranche.ProtoReflect.Descriptor instead.
+func (*VestingTranche) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VestingTranche) GetVestAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VestAt
+	}
+	return nil
+}
+
+func (x *VestingTranche) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RiskSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// hold or deny
+	Decision string `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Detail   string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *RiskSignal) Reset() {
+	*x = RiskSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskSignal) ProtoMessage() {}
+
+func (x *RiskSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskSignal.ProtoReflect.Descriptor instead.
+func (*RiskSignal) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RiskSignal) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *RiskSignal) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *RiskSignal) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type RewardReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StockSymbol    string `protobuf:"bytes,4,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	// hold or deny
+	Decision string        `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	Signals  []*RiskSignal `protobuf:"bytes,6,rep,name=signals,proto3" json:"signals,omitempty"`
+	// pending, approved, rejected or denied
+	Status    string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *RewardReview) Reset() {
+	*x = RewardReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReview) ProtoMessage() {}
+
+func (x *RewardReview) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReview.ProtoReflect.Descriptor instead.
+func (*RewardReview) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RewardReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RewardReview) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RewardReview) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RewardReview) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *RewardReview) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *RewardReview) GetSignals() []*RiskSignal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+func (x *RewardReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RewardReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPortfolioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetPortfolioRequest) Reset() {
+	*x = GetPortfolioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioRequest) ProtoMessage() {}
+
+func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPortfolioRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPortfolioResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Summary  *PortfolioSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Holdings []*Holding        `protobuf:"bytes,3,rep,name=holdings,proto3" json:"holdings,omitempty"`
+}
+
+func (x *GetPortfolioResponse) Reset() {
+	*x = GetPortfolioResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioResponse) ProtoMessage() {}
+
+func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPortfolioResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPortfolioResponse) GetSummary() *PortfolioSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetPortfolioResponse) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type PortfolioSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalValue      float64 `protobuf:"fixed64,1,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	TotalCost       float64 `protobuf:"fixed64,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	TotalProfitLoss float64 `protobuf:"fixed64,3,opt,name=total_profit_loss,json=totalProfitLoss,proto3" json:"total_profit_loss,omitempty"`
+	HoldingsCount   int32   `protobuf:"varint,4,opt,name=holdings_count,json=holdingsCount,proto3" json:"holdings_count,omitempty"`
+}
+
+func (x *PortfolioSummary) Reset() {
+	*x = PortfolioSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioSummary) ProtoMessage() {}
+
+func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioSummary.ProtoReflect.Descriptor instead.
+func (*PortfolioSummary) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PortfolioSummary) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetTotalProfitLoss() float64 {
+	if x != nil {
+		return x.TotalProfitLoss
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetHoldingsCount() int32 {
+	if x != nil {
+		return x.HoldingsCount
+	}
+	return 0
+}
+
+type Holding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockSymbol    string  `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	CompanyName    string  `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	TotalShares    float64 `protobuf:"fixed64,3,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	AveragePrice   float64 `protobuf:"fixed64,4,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	CurrentPrice   float64 `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	CurrentValue   float64 `protobuf:"fixed64,6,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	TotalCost      float64 `protobuf:"fixed64,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	ProfitLoss     float64 `protobuf:"fixed64,8,opt,name=profit_loss,json=profitLoss,proto3" json:"profit_loss,omitempty"`
+	ProfitLossPct  float64 `protobuf:"fixed64,9,opt,name=profit_loss_pct,json=profitLossPct,proto3" json:"profit_loss_pct,omitempty"`
+	VestedShares   float64 `protobuf:"fixed64,10,opt,name=vested_shares,json=vestedShares,proto3" json:"vested_shares,omitempty"`
+	UnvestedShares float64 `protobuf:"fixed64,11,opt,name=unvested_shares,json=unvestedShares,proto3" json:"unvested_shares,omitempty"`
+	// Listing whose price values the holding; empty for a symbol missing from stocks
+	Exchange string `protobuf:"bytes,12,opt,name=exchange,proto3" json:"exchange,omitempty"`
+}
+
+func (x *Holding) Reset() {
+	*x = Holding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Holding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holding) ProtoMessage() {}
+
+func (x *Holding) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holding.ProtoReflect.Descriptor instead.
+func (*Holding) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Holding) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *Holding) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Holding) GetTotalShares() float64 {
+	if x != nil {
+		return x.TotalShares
+	}
+	return 0
+}
+
+func (x *Holding) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *Holding) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *Holding) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *Holding) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *Holding) GetProfitLoss() float64 {
+	if x != nil {
+		return x.ProfitLoss
+	}
+	return 0
+}
+
+func (x *Holding) GetProfitLossPct() float64 {
+	if x != nil {
+		return x.ProfitLossPct
+	}
+	return 0
+}
+
+func (x *Holding) GetVestedShares() float64 {
+	if x != nil {
+		return x.VestedShares
+	}
+	return 0
+}
+
+func (x *Holding) GetUnvestedShares() float64 {
+	if x != nil {
+		return x.UnvestedShares
+	}
+	return 0
+}
+
+func (x *Holding) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId              string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TodayRewards        []*StockRewardSummary `protobuf:"bytes,2,rep,name=today_rewards,json=todayRewards,proto3" json:"today_rewards,omitempty"`
+	PortfolioValueInr   float64               `protobuf:"fixed64,3,opt,name=portfolio_value_inr,json=portfolioValueInr,proto3" json:"portfolio_value_inr,omitempty"`
+	TotalSharesRewarded float64               `protobuf:"fixed64,4,opt,name=total_shares_rewarded,json=totalSharesRewarded,proto3" json:"total_shares_rewarded,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetStatsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsResponse) GetTodayRewards() []*StockRewardSummary {
+	if x != nil {
+		return x.TodayRewards
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetPortfolioValueInr() float64 {
+	if x != nil {
+		return x.PortfolioValueInr
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetTotalSharesRewarded() float64 {
+	if x != nil {
+		return x.TotalSharesRewarded
+	}
+	return 0
+}
+
+type StockRewardSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockSymbol string  `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	TotalShares float64 `protobuf:"fixed64,2,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	RewardCount int32   `protobuf:"varint,3,opt,name=reward_count,json=rewardCount,proto3" json:"reward_count,omitempty"`
+}
+
+func (x *StockRewardSummary) Reset() {
+	*x = StockRewardSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocky_v1_rewards_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockRewardSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockRewardSummary) ProtoMessage() {}
+
+func (x *StockRewardSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_stocky_v1_rewards_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockRewardSummary.ProtoReflect.Descriptor instead.
+func (*StockRewardSummary) Descriptor() ([]byte, []int) {
+	return file_stocky_v1_rewards_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StockRewardSummary) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *StockRewardSummary) GetTotalShares() float64 {
+	if x != nil {
+		return x.TotalShares
+	}
+	return 0
+}
+
+func (x *StockRewardSummary) GetRewardCount() int32 {
+	if x != nil {
+		return x.RewardCount
+	}
+	return 0
+}
+
+var File_stocky_v1_rewards_proto protoreflect.FileDescriptor
+
+var file_stocky_v1_rewards_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x73, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x69,
+	0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x66,
+	0x66, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c,
+	0x69, 0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x15, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x55, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x54,
+	0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xde, 0x06, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x72, 0x6f, 0x6b, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x74, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73,
+	0x74, 0x74, 0x46, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x67, 0x73, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x62, 0x69, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x65, 0x62, 0x69, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x64, 0x75, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x44, 0x75, 0x74, 0x79, 0x46,
+	0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x69, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x73, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x66,
+	0x66, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x41, 0x74, 0x12,
+	0x31, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64,
+	0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x61, 0x0a, 0x0e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x76, 0x65, 0x73, 0x74, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x22, 0x56, 0x0a, 0x0a, 0x52, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xa3, 0x02, 0x0a,
+	0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x73, 0x6b,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x68,
+	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x10,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xb3, 0x03, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x63, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x75,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x6f, 0x64, 0x61, 0x79,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x12, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x79, 0x0a, 0x0c, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45,
+	0x57, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x57,
+	0x41, 0x52, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52,
+	0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xd9, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x24,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x1e, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x2f,
+	0x76, 0x31, 0x3b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stocky_v1_rewards_proto_rawDescOnce sync.Once
+	file_stocky_v1_rewards_proto_rawDescData = file_stocky_v1_rewards_proto_rawDesc
+)
+
+func file_stocky_v1_rewards_proto_rawDescGZIP() []byte {
+	file_stocky_v1_rewards_proto_rawDescOnce.Do(func() {
+		file_stocky_v1_rewards_proto_rawDescData = protoimpl.X.CompressGZIP(file_stocky_v1_rewards_proto_rawDescData)
+	})
+	return file_stocky_v1_rewards_proto_rawDescData
+}
+
+var file_stocky_v1_rewards_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stocky_v1_rewards_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_stocky_v1_rewards_proto_goTypes = []any{
+	(RewardStatus)(0),                  // 0: stocky.v1.RewardStatus
+	(*CreateRewardRequest)(nil),        // 1: stocky.v1.CreateRewardRequest
+	(*VestingRequest)(nil),             // 2: stocky.v1.VestingRequest
+	(*VestingTrancheRequest)(nil),      // 3: stocky.v1.VestingTrancheRequest
+	(*CreateRewardResponse)(nil),       // 4: stocky.v1.CreateRewardResponse
+	(*BatchCreateRewardsRequest)(nil),  // 5: stocky.v1.BatchCreateRewardsRequest
+	(*BatchCreateRewardsResponse)(nil), // 6: stocky.v1.BatchCreateRewardsResponse
+	(*BatchRewardResult)(nil),          // 7: stocky.v1.BatchRewardResult
+	(*RewardEvent)(nil),                // 8: stocky.v1.RewardEvent
+	(*VestingSchedule)(nil),            // 9: stocky.v1.VestingSchedule
+	(*VestingTranche)(nil),             // 10: stocky.v1.VestingTranche
+	(*RiskSignal)(nil),                 // 11: stocky.v1.RiskSignal
+	(*RewardReview)(nil),               // 12: stocky.v1.RewardReview
+	(*GetPortfolioRequest)(nil),        // 13: stocky.v1.GetPortfolioRequest
+	(*GetPortfolioResponse)(nil),       // 14: stocky.v1.GetPortfolioResponse
+	(*PortfolioSummary)(nil),           // 15: stocky.v1.PortfolioSummary
+	(*Holding)(nil),                    // 16: stocky.v1.Holding
+	(*GetStatsRequest)(nil),            // 17: stocky.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),           // 18: stocky.v1.GetStatsResponse
+	(*StockRewardSummary)(nil),         // 19: stocky.v1.StockRewardSummary
+	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
+}
+var file_stocky_v1_rewards_proto_depIdxs = []int32{
+	20, // 0: stocky.v1.CreateRewardRequest.rewarded_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: stocky.v1.CreateRewardRequest.vesting:type_name -> stocky.v1.VestingRequest
+	3,  // 2: stocky.v1.VestingRequest.tranches:type_name -> stocky.v1.VestingTrancheRequest
+	0,  // 3: stocky.v1.CreateRewardResponse.status:type_name -> stocky.v1.RewardStatus
+	8,  // 4: stocky.v1.CreateRewardResponse.reward:type_name -> stocky.v1.RewardEvent
+	12, // 5: stocky.v1.CreateRewardResponse.review:type_name -> stocky.v1.RewardReview
+	1,  // 6: stocky.v1.BatchCreateRewardsRequest.rewards:type_name -> stocky.v1.CreateRewardRequest
+	7,  // 7: stocky.v1.BatchCreateRewardsResponse.results:type_name -> stocky.v1.BatchRewardResult
+	0,  // 8: stocky.v1.BatchRewardResult.status:type_name -> stocky.v1.RewardStatus
+	8,  // 9: stocky.v1.BatchRewardResult.reward:type_name -> stocky.v1.RewardEvent
+	12, // 10: stocky.v1.BatchRewardResult.review:type_name -> stocky.v1.RewardReview
+	20, // 11: stocky.v1.RewardEvent.rewarded_at:type_name -> google.protobuf.Timestamp
+	11, // 12: stocky.v1.RewardEvent.risk_signals:type_name -> stocky.v1.RiskSignal
+	20, // 13: stocky.v1.RewardEvent.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 14: stocky.v1.RewardEvent.vesting:type_name -> stocky.v1.VestingSchedule
+	20, // 15: stocky.v1.VestingSchedule.start_at:type_name -> google.protobuf.Timestamp
+	20, // 16: stocky.v1.VestingSchedule.cliff_at:type_name -> google.protobuf.Timestamp
+	20, // 17: stocky.v1.VestingSchedule.end_at:type_name -> google.protobuf.Timestamp
+	10, // 18: stocky.v1.VestingSchedule.tranches:type_name -> stocky.v1.VestingTranche
+	20, // 19: stocky.v1.VestingTranche.vest_at:type_name -> google.protobuf.Timestamp
+	11, // 20: stocky.v1.RewardReview.signals:type_name -> stocky.v1.RiskSignal
+	20, // 21: stocky.v1.RewardReview.created_at:type_name -> google.protobuf.Timestamp
+	15, // 22: stocky.v1.GetPortfolioResponse.summary:type_name -> stocky.v1.PortfolioSummary
+	16, // 23: stocky.v1.GetPortfolioResponse.holdings:type_name -> stocky.v1.Holding
+	19, // 24: stocky.v1.GetStatsResponse.today_rewards:type_name -> stocky.v1.StockRewardSummary
+	1,  // 25: stocky.v1.RewardService.CreateReward:input_type -> stocky.v1.CreateRewardRequest
+	5,  // 26: stocky.v1.RewardService.BatchCreateRewards:input_type -> stocky.v1.BatchCreateRewardsRequest
+	13, // 27: stocky.v1.RewardService.GetPortfolio:input_type -> stocky.v1.GetPortfolioRequest
+	17, // 28: stocky.v1.RewardService.GetStats:input_type -> stocky.v1.GetStatsRequest
+	4,  // 29: stocky.v1.RewardService.CreateReward:output_type -> stocky.v1.CreateRewardResponse
+	6,  // 30: stocky.v1.RewardService.BatchCreateRewards:output_type -> stocky.v1.BatchCreateRewardsResponse
+	14, // 31: stocky.v1.RewardService.GetPortfolio:output_type -> stocky.v1.GetPortfolioResponse
+	18, // 32: stocky.v1.RewardService.GetStats:output_type -> stocky.v1.GetStatsResponse
+	29, // [29:33] is the sub-list for method output_type
+	25, // [25:29] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_stocky_v1_rewards_proto_init() }
+func file_stocky_v1_rewards_proto_init() {
+	if File_stocky_v1_rewards_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_stocky_v1_rewards_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VestingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*VestingTrancheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRewardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchRewardResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RewardEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*VestingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*VestingTranche); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RiskSignal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RewardReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPortfolioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPortfolioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*PortfolioSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Holding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocky_v1_rewards_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*StockRewardSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stocky_v1_rewards_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stocky_v1_rewards_proto_goTypes,
+		DependencyIndexes: file_stocky_v1_rewards_proto_depIdxs,
+		EnumInfos:         file_stocky_v1_rewards_proto_enumTypes,
+		MessageInfos:      file_stocky_v1_rewards_proto_msgTypes,
+	}.Build()
+	File_stocky_v1_rewards_proto = out.File
+	file_stocky_v1_rewards_proto_rawDesc = nil
+	file_stocky_v1_rewards_proto_goTypes = nil
+	file_stocky_v1_rewards_proto_depIdxs = nil
+}
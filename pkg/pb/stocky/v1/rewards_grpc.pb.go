@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: stocky/v1/rewards.proto
+
+package stockyv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RewardService_CreateReward_FullMethodName       = "/stocky.v1.RewardService/CreateReward"
+	RewardService_BatchCreateRewards_FullMethodName = "/stocky.v1.RewardService/BatchCreateRewards"
+	RewardService_GetPortfolio_FullMethodName       = "/stocky.v1.RewardService/GetPortfolio"
+	RewardService_GetStats_FullMethodName           = "/stocky.v1.RewardService/GetStats"
+)
+
+// RewardServiceClient is the client API for RewardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RewardService issues stock rewards and reads holdings for internal services.
+// It runs on its own port and shares the REST API's service layer, so rewards go
+// through the same idempotency, budget and risk checks.
+//
+// Callers may set x-request-id metadata, as the REST header, to correlate the
+// call. Calls are not authenticated and are audited as anonymous.
+type RewardServiceClient interface {
+	// CreateReward awards shares to a user. Replaying an idempotency_key returns
+	// the original reward. A reward held by the risk checks succeeds with status
+	// HELD and its review. Failures use the REST status codes' gRPC equivalents:
+	// INVALID_ARGUMENT (400), PERMISSION_DENIED when the risk checks deny it (403)
+	// and FAILED_PRECONDITION when it exceeds a budget (422). Denials and budget
+	// breaches carry a google.rpc.ErrorInfo detail.
+	CreateReward(ctx context.Context, in *CreateRewardRequest, opts ...grpc.CallOption) (*CreateRewardResponse, error)
+	// BatchCreateRewards issues up to 500 rewards in order. Each reward is created
+	// on its own, with its own idempotency key, so one failure does not stop the
+	// rest; the result for each request is at the same index of results.
+	BatchCreateRewards(ctx context.Context, in *BatchCreateRewardsRequest, opts ...grpc.CallOption) (*BatchCreateRewardsResponse, error)
+	// GetPortfolio returns a user's holdings valued at current prices
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	// GetStats returns today's rewards by stock and the current portfolio value
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+}
+
+type rewardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRewardServiceClient(cc grpc.ClientConnInterface) RewardServiceClient {
+	return &rewardServiceClient{cc}
+}
+
+func (c *rewardServiceClient) CreateReward(ctx context.Context, in *CreateRewardRequest, opts ...grpc.CallOption) (*CreateRewardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRewardResponse)
+	err := c.cc.Invoke(ctx, RewardService_CreateReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) BatchCreateRewards(ctx context.Context, in *BatchCreateRewardsRequest, opts ...grpc.CallOption) (*BatchCreateRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateRewardsResponse)
+	err := c.cc.Invoke(ctx, RewardService_BatchCreateRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, RewardService_GetPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, RewardService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardServiceServer is the server API for RewardService service.
+// All implementations must embed UnimplementedRewardServiceServer
+// for forward compatibility
+//
+// RewardService issues stock rewards and reads holdings for internal services.
+// It runs on its own port and shares the REST API's service layer, so rewards go
+// through the same idempotency, budget and risk checks.
+//
+// Callers may set x-request-id metadata, as the REST header, to correlate the
+// call. Calls are not authenticated and are audited as anonymous.
+type RewardServiceServer interface {
+	// CreateReward awards shares to a user. Replaying an idempotency_key returns
+	// the original reward. A reward held by the risk checks succeeds with status
+	// HELD and its review. Failures use the REST status codes' gRPC equivalents:
+	// INVALID_ARGUMENT (400), PERMISSION_DENIED when the risk checks deny it (403)
+	// and FAILED_PRECONDITION when it exceeds a budget (422). Denials and budget
+	// breaches carry a google.rpc.ErrorInfo detail.
+	CreateReward(context.Context, *CreateRewardRequest) (*CreateRewardResponse, error)
+	// BatchCreateRewards issues up to 500 rewards in order. Each reward is created
+	// on its own, with its own idempotency key, so one failure does not stop the
+	// rest; the result for each request is at the same index of results.
+	BatchCreateRewards(context.Context, *BatchCreateRewardsRequest) (*BatchCreateRewardsResponse, error)
+	// GetPortfolio returns a user's holdings valued at current prices
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	// GetStats returns today's rewards by stock and the current portfolio value
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+// UnimplementedRewardServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRewardServiceServer struct {
+}
+
+func (UnimplementedRewardServiceServer) CreateReward(context.Context, *CreateRewardRequest) (*CreateRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReward not implemented")
+}
+func (UnimplementedRewardServiceServer) BatchCreateRewards(context.Context, *BatchCreateRewardsRequest) (*BatchCreateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateRewards not implemented")
+}
+func (UnimplementedRewardServiceServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedRewardServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedRewardServiceServer) mustEmbedUnimplementedRewardServiceServer() {}
+
+// UnsafeRewardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RewardServiceServer will
+// result in compilation errors.
+type UnsafeRewardServiceServer interface {
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+func RegisterRewardServiceServer(s grpc.ServiceRegistrar, srv RewardServiceServer) {
+	s.RegisterService(&RewardService_ServiceDesc, srv)
+}
+
+func _RewardService_CreateReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).CreateReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_CreateReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).CreateReward(ctx, req.(*CreateRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_BatchCreateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).BatchCreateRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_BatchCreateRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).BatchCreateRewards(ctx, req.(*BatchCreateRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RewardService_ServiceDesc is the grpc.ServiceDesc for RewardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RewardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stocky.v1.RewardService",
+	HandlerType: (*RewardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReward",
+			Handler:    _RewardService_CreateReward_Handler,
+		},
+		{
+			MethodName: "BatchCreateRewards",
+			Handler:    _RewardService_BatchCreateRewards_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _RewardService_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _RewardService_GetStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stocky/v1/rewards.proto",
+}